@@ -0,0 +1,302 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"oikotie/database/models"
+	"oikotie/scraper"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func init() {
+	areasListCmd.Flags().Bool("tracked", false, "Only list tracked areas")
+	areasAddCmd.Flags().Int("card-id", 0, "Pin the query to this card, or track the known area of this card without a query, see ot areas search")
+	addFetcherFlags(areasSearchCmd)
+	addFetcherFlags(areasAddCmd)
+	areasCmd.AddCommand(areasSearchCmd, areasListCmd, areasAddCmd, areasRemoveCmd, areasShowCmd)
+	rootCmd.AddCommand(areasCmd)
+}
+
+var areasCmd = &cobra.Command{
+	Use:   "areas",
+	Short: "Look up and manage areas",
+	Long: `Look up and manage areas. Tracked areas are scraped instead of the
+configured ones when the search config has "areasFromDB": true.`,
+}
+
+var areasSearchCmd = &cobra.Command{
+	Use:   "search <query>",
+	Short: "List every location card matching a query",
+	Long: `List every location card matching a query with its card id, type and
+parent city. Pin a query to a card in the search config with
+{"query": "<query>", "cardId": <card id>}.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		di := setup()
+
+		search, err := newScraper(cmd, di)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		candidates, err := search.SearchAreas(cmd.Context(), args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "CARD ID\tTYPE\tNAME\tCITY\t")
+		for _, c := range candidates {
+			cardType := scraper.CardTypeName(c.CardType)
+			if !c.Accepted {
+				cardType += " (not scrapable)"
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t\n", c.CardID, cardType, c.Name, c.City)
+		}
+		w.Flush()
+	},
+}
+
+var areasListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List known areas",
+	Long: `List known areas. The listing counts include the listings of the areas
+below each area, as in areas show.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		di := setup()
+		ctx := cmd.Context()
+
+		mods := []qm.QueryMod{qm.OrderBy(models.AreaColumns.ID)}
+		if tracked, _ := cmd.Flags().GetBool("tracked"); tracked {
+			mods = append(mods, models.AreaWhere.Tracked.EQ(true))
+		}
+
+		areas, err := models.Areas(mods...).All(ctx, di.db)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		counts, err := activeListingCounts(ctx, di.db)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "CARD ID\tTYPE\tNAME\tCITY\tQUERY\tTRACKED\tLISTINGS\tLAST SCRAPED\t")
+		for _, a := range areas {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%t\t%d\t%s\t\n",
+				a.ExternalID, scraper.CardTypeName(a.CardType), a.Name, a.City,
+				a.Query.String, a.Tracked, counts[a.ID], formatTime(a.LastScrapedAt))
+		}
+		w.Flush()
+	},
+}
+
+var areasAddCmd = &cobra.Command{
+	Use:   "add [query]",
+	Short: "Track an area",
+	Long: `Track an area given by a location query, e.g. a postcode or "Otaniemi, Espoo".
+--card-id pins the query to a card, or without a query tracks an already known
+area by its card id. A number is always a query, as postcodes are numbers.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cardID, _ := cmd.Flags().GetInt("card-id")
+		q := scraper.AreaQuery{CardID: cardID}
+		if len(args) > 0 {
+			q.Query = args[0]
+		}
+		if q.Query == "" && q.CardID == 0 {
+			log.Fatal("Give a query or a --card-id")
+		}
+
+		di := setup()
+		ctx := cmd.Context()
+
+		search, err := newScraper(cmd, di)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		area, err := search.AddArea(ctx, q)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		log.Printf("Tracking %s, %s (card %d)", area.Name, area.City, area.ExternalID)
+	},
+}
+
+var areasRemoveCmd = &cobra.Command{
+	Use:   "remove <name|query|card-id>",
+	Short: "Stop tracking an area",
+	Long: `Stop tracking an area. The area is deleted if it has no listings, including
+ones marked removed, otherwise it's kept along with its listings.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		di := setup()
+		ctx := cmd.Context()
+
+		area, err := findArea(ctx, di.db, args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		hasListings, err := area.Listings().Exists(ctx, di.db)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if !hasListings {
+			_, err = area.Delete(ctx, di.db)
+			if err != nil {
+				log.Fatal(err)
+			}
+			log.Printf("Deleted %s, %s", area.Name, area.City)
+			return
+		}
+
+		area.Tracked = false
+		_, err = area.Update(ctx, di.db, boil.Whitelist(models.AreaColumns.Tracked))
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Stopped tracking %s, %s", area.Name, area.City)
+	},
+}
+
+var areasShowCmd = &cobra.Command{
+	Use:   "show <name|query|card-id>",
+	Short: "Show an area with its listing counts and last scrape time",
+	Long: `Show an area with its listing counts and last scrape time. The counts
+include the listings of the areas below it.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		di := setup()
+		ctx := cmd.Context()
+
+		area, err := findArea(ctx, di.db, args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		areaIDs, err := scraper.DescendantAreaIDs(ctx, di.db, area.ID)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		total, err := models.Listings(models.ListingWhere.AreaID.IN(areaIDs)).Count(ctx, di.db)
+		if err != nil {
+			log.Fatal(err)
+		}
+		active, err := models.Listings(
+			models.ListingWhere.AreaID.IN(areaIDs),
+			models.ListingWhere.RemovedAt.IsNull(),
+		).Count(ctx, di.db)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		parent := "-"
+		if area.ParentID.Valid {
+			p, err := area.Parent().One(ctx, di.db)
+			if err != nil {
+				log.Fatal(err)
+			}
+			parent = fmt.Sprintf("%s (card %d)", p.Name, p.ExternalID)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "Name:\t%s\n", area.Name)
+		fmt.Fprintf(w, "City:\t%s\n", area.City)
+		fmt.Fprintf(w, "Card:\t%d (%s)\n", area.ExternalID, scraper.CardTypeName(area.CardType))
+		fmt.Fprintf(w, "Parent:\t%s\n", parent)
+		fmt.Fprintf(w, "Sub-areas:\t%d\n", len(areaIDs)-1)
+		fmt.Fprintf(w, "Query:\t%s\n", area.Query.String)
+		fmt.Fprintf(w, "Tracked:\t%t\n", area.Tracked)
+		fmt.Fprintf(w, "Listings:\t%d active, %d removed\n", active, total-active)
+		fmt.Fprintf(w, "Last scraped:\t%s\n", formatTime(area.LastScrapedAt))
+		w.Flush()
+	},
+}
+
+// findArea finds the one area with the given name, query or card id
+func findArea(ctx context.Context, db *sql.DB, arg string) (*models.Area, error) {
+	mods := []qm.QueryMod{
+		models.AreaWhere.Name.EQ(arg),
+		qm.Or2(models.AreaWhere.Query.EQ(null.StringFrom(arg))),
+	}
+	if id, err := strconv.Atoi(arg); err == nil {
+		mods = append(mods, qm.Or2(models.AreaWhere.ExternalID.EQ(id)))
+	}
+
+	areas, err := models.Areas(mods...).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(areas) {
+	case 0:
+		return nil, fmt.Errorf("No area named %q", arg)
+	case 1:
+		return areas[0], nil
+	}
+
+	matches := make([]string, len(areas))
+	for i, a := range areas {
+		matches[i] = fmt.Sprintf("%s, %s (card %d)", a.Name, a.City, a.ExternalID)
+	}
+	return nil, fmt.Errorf("%q matches %d areas, give a card id: %s", arg, len(areas), strings.Join(matches, "; "))
+}
+
+type areaCount struct {
+	AreaID int `boil:"area_id"`
+	Count  int `boil:"count"`
+}
+
+// activeListingCounts counts the listings not marked removed by area id,
+// including the listings of the areas below each area
+func activeListingCounts(ctx context.Context, db *sql.DB) (map[int]int, error) {
+	var rows []areaCount
+	err := queries.Raw(`
+		WITH RECURSIVE descendants(area_id, id) AS (
+			SELECT id, id FROM areas
+			UNION
+			SELECT d.area_id, areas.id FROM areas JOIN descendants d ON areas.parent_id = d.id
+		)
+		SELECT d.area_id, count(*) AS count
+		FROM descendants d
+		JOIN listings l ON l.area_id = d.id
+		WHERE l.removed_at IS NULL
+		GROUP BY d.area_id`,
+	).Bind(ctx, db, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[int]int{}
+	for _, row := range rows {
+		counts[row.AreaID] = row.Count
+	}
+
+	return counts, nil
+}
+
+func formatTime(t null.Time) string {
+	if !t.Valid {
+		return "never"
+	}
+	return t.Time.Local().Format(time.RFC822)
+}
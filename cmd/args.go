@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"oikotie/database/models"
+	"oikotie/geo"
+	"oikotie/scraper"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// areaIDsByName looks up the ids of the areas with the given names or the
+// queries they were resolved from, along with the ids of the areas below them
+func areaIDsByName(ctx context.Context, db *sql.DB, names []string) ([]int, error) {
+	values := make([]interface{}, len(names))
+	for i, name := range names {
+		values[i] = name
+	}
+
+	areas, err := models.Areas(
+		models.AreaWhere.Name.IN(names),
+		qm.OrIn(models.AreaColumns.Query+" IN ?", values...),
+	).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if len(areas) == 0 {
+		return nil, fmt.Errorf("no areas named %v", names)
+	}
+
+	ids := []int{}
+	for _, area := range areas {
+		descendants, err := scraper.DescendantAreaIDs(ctx, db, area.ID)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, descendants...)
+	}
+
+	return ids, nil
+}
+
+// parseAge parses a duration that may also be given in days, e.g. "7d"
+func parseAge(value string) (time.Duration, error) {
+	if days := strings.TrimSuffix(value, "d"); days != value {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid age %q", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	return time.ParseDuration(value)
+}
+
+// parsePoint parses a "latitude,longitude" pair, e.g. "60.17,24.94"
+func parsePoint(value string) (geo.Point, error) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 2 {
+		return geo.Point{}, fmt.Errorf("invalid point %q, expected latitude,longitude", value)
+	}
+
+	lat, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return geo.Point{}, fmt.Errorf("invalid latitude in %q", value)
+	}
+	lng, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil || lng < -180 || lng > 180 {
+		return geo.Point{}, fmt.Errorf("invalid longitude in %q", value)
+	}
+
+	return geo.Point{Latitude: lat, Longitude: lng}, nil
+}
+
+// parseDistance parses a distance into meters, e.g. "1.5km" or "800m"
+func parseDistance(value string) (float64, error) {
+	number := strings.ToLower(strings.TrimSpace(value))
+	unit := 1.0
+	if n := strings.TrimSuffix(number, "km"); n != number {
+		number, unit = n, 1000
+	} else {
+		number = strings.TrimSuffix(number, "m")
+	}
+
+	n, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid distance %q, e.g. 1.5km or 800m", value)
+	}
+
+	return n * unit, nil
+}
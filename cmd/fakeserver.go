@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"oikotie/replay"
+
+	"github.com/spf13/cobra"
+)
+
+var fakeserverAddr string
+
+func init() {
+	fakeserverCmd.Flags().StringVar(&fakeserverAddr, "addr", "localhost:8080", "Address to listen on")
+	rootCmd.AddCommand(fakeserverCmd)
+}
+
+var fakeserverCmd = &cobra.Command{
+	Use:   "fakeserver <dir>",
+	Short: "Serve requests recorded with update --record as a fake Oikotie",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		server, err := replay.Load(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		httpServer := &http.Server{Addr: fakeserverAddr, Handler: server}
+		go func() {
+			<-cmd.Context().Done()
+			_ = httpServer.Shutdown(context.Background())
+		}()
+
+		log.Printf("Serving %d recorded requests on http://%s", server.Len(), fakeserverAddr)
+		err = httpServer.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.Fatal(err)
+		}
+	},
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"oikotie/config"
+	"oikotie/replay"
+	"oikotie/scraper"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// addFetcherFlags adds flags overriding the HTTP settings of the search config
+func addFetcherFlags(cmd *cobra.Command) {
+	defaults := scraper.DefaultFetcherOptions()
+	cmd.Flags().Int("retry-max", defaults.RetryMax, "Maximum number of retries per request")
+	cmd.Flags().Duration("retry-wait-min", defaults.RetryWaitMin, "Minimum wait between retries")
+	cmd.Flags().Duration("retry-wait-max", defaults.RetryWaitMax, "Maximum wait between retries")
+	cmd.Flags().Duration("retry-after-max", defaults.RetryAfterMax, "Longest Retry-After wait honoured, a request asked to wait longer fails")
+	cmd.Flags().Duration("timeout", defaults.Timeout, "Timeout of a single request attempt")
+	cmd.Flags().String("user-agent", defaults.UserAgent, "User-Agent header sent with every request")
+	cmd.Flags().Float64("requests-per-second", defaults.RequestsPerSecond, "Request rate limit per host, 0 disables the limit")
+	cmd.Flags().String("record", "", "Save every request and response to this directory, for use with fakeserver")
+	cmd.Flags().String("base-url", "", "Oikotie base URL, e.g. a fakeserver at http://localhost:8080")
+}
+
+// baseURL resolves the Oikotie base URL from the flag, the search config or the default
+func baseURL(cmd *cobra.Command, cfg *config.SearchConfig) string {
+	if u, _ := cmd.Flags().GetString("base-url"); u != "" {
+		return u
+	}
+	if cfg.BaseURL != "" {
+		return cfg.BaseURL
+	}
+	return scraper.DefaultBaseURL
+}
+
+// fetcherOptions resolves the HTTP settings from defaults, the search config and
+// flags, in increasing order of precedence
+func fetcherOptions(cmd *cobra.Command, cfg *config.SearchConfig) (scraper.FetcherOptions, error) {
+	o := scraper.DefaultFetcherOptions()
+
+	if cfg.RequestsPerSecond > 0 {
+		o.RequestsPerSecond = cfg.RequestsPerSecond
+	} else if cfg.RequestsPerSecond < 0 {
+		o.RequestsPerSecond = 0
+	}
+
+	if h := cfg.HTTP; h != nil {
+		if h.RetryMax != nil {
+			o.RetryMax = *h.RetryMax
+		}
+		if h.UserAgent != "" {
+			o.UserAgent = h.UserAgent
+		}
+
+		durations := []struct {
+			name  string
+			value string
+			dst   *time.Duration
+		}{
+			{"retryWaitMin", h.RetryWaitMin, &o.RetryWaitMin},
+			{"retryWaitMax", h.RetryWaitMax, &o.RetryWaitMax},
+			{"retryAfterMax", h.RetryAfterMax, &o.RetryAfterMax},
+			{"timeout", h.Timeout, &o.Timeout},
+		}
+		for _, d := range durations {
+			if d.value == "" {
+				continue
+			}
+			parsed, err := time.ParseDuration(d.value)
+			if err != nil {
+				return o, fmt.Errorf("http.%s, %w", d.name, err)
+			}
+			*d.dst = parsed
+		}
+	}
+
+	flags := cmd.Flags()
+	var err error
+	if flags.Changed("retry-max") {
+		o.RetryMax, err = flags.GetInt("retry-max")
+	}
+	if err == nil && flags.Changed("retry-wait-min") {
+		o.RetryWaitMin, err = flags.GetDuration("retry-wait-min")
+	}
+	if err == nil && flags.Changed("retry-wait-max") {
+		o.RetryWaitMax, err = flags.GetDuration("retry-wait-max")
+	}
+	if err == nil && flags.Changed("retry-after-max") {
+		o.RetryAfterMax, err = flags.GetDuration("retry-after-max")
+	}
+	if err == nil && flags.Changed("timeout") {
+		o.Timeout, err = flags.GetDuration("timeout")
+	}
+	if err == nil && flags.Changed("user-agent") {
+		o.UserAgent, err = flags.GetString("user-agent")
+	}
+	if err == nil && flags.Changed("requests-per-second") {
+		o.RequestsPerSecond, err = flags.GetFloat64("requests-per-second")
+	}
+	if err != nil {
+		return o, err
+	}
+
+	if dir, _ := flags.GetString("record"); dir != "" {
+		o.Transport, err = replay.NewRecorder(dir, http.DefaultTransport)
+	}
+
+	return o, err
+}
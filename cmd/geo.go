@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"oikotie/geo"
+	"oikotie/scraper"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+// boundaryProperties are the properties checked for the code and the name of
+// a boundary by its type, covering Statistics Finland's postcode areas and
+// the district maps of the cities
+var boundaryProperties = map[string]struct {
+	code []string
+	name []string
+}{
+	"postcode": {
+		code: []string{"postinumeroalue", "posti_alue", "postinumer", "postcode"},
+		name: []string{"nimi", "name"},
+	},
+	"neighbourhood": {
+		code: []string{"tunnus", "kokotunnus", "code", "nimi_fi", "nimi", "name"},
+		name: []string{"nimi_fi", "nimi", "name"},
+	},
+}
+
+func init() {
+	geoImportCmd.Flags().String("type", "postcode", "Type of the boundaries, postcode or neighbourhood")
+	geoImportCmd.Flags().String("code-property", "", "Property with the postcode or district code")
+	geoImportCmd.Flags().String("name-property", "", "Property with the area name")
+	geoImportCmd.Flags().String("city", "", "City of all the boundaries, required for neighbourhoods as district names repeat across cities")
+	geoImportCmd.Flags().Int("srid", 0, "SRID of the coordinates, when the file doesn't tell, e.g. 3067 for ETRS-TM35FIN")
+	geoCmd.AddCommand(geoImportCmd, geoAssignCmd)
+	rootCmd.AddCommand(geoCmd)
+}
+
+var geoCmd = &cobra.Command{
+	Use:   "geo",
+	Short: "Import area boundaries and place listings in them",
+}
+
+var geoImportCmd = &cobra.Command{
+	Use:   "import <geojson|shapefile>",
+	Short: "Import postcode or district boundaries",
+	Long: `Import postcode or district boundaries from a GeoJSON file or a shapefile
+(.shp, with its .dbf and .prj next to it). Boundaries are linked to the known
+areas they're of, and listings are moved to the most specific area whose
+boundary contains them. Importing a boundary again replaces it.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		di := setup()
+		ctx := cmd.Context()
+
+		typeName, _ := cmd.Flags().GetString("type")
+		cardType, ok := scraper.CardTypeByName(typeName)
+		props, known := boundaryProperties[typeName]
+		if !ok || !known {
+			log.Fatalf("Invalid type %q, expected postcode or neighbourhood", typeName)
+		}
+
+		o := geo.ImportOptions{
+			CardType: cardType,
+			Code:     props.code,
+			Name:     props.name,
+			Source:   filepath.Base(args[0]),
+		}
+		if code, _ := cmd.Flags().GetString("code-property"); code != "" {
+			o.Code = []string{code}
+		}
+		if name, _ := cmd.Flags().GetString("name-property"); name != "" {
+			o.Name = []string{name}
+		}
+		o.City, _ = cmd.Flags().GetString("city")
+		if typeName == "neighbourhood" && o.City == "" {
+			log.Fatal("Give the --city of the neighbourhoods, their names repeat across cities")
+		}
+		o.SRID, _ = cmd.Flags().GetInt("srid")
+
+		layer, err := geo.Read(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		imported, err := geo.Import(ctx, di.db, layer, o)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Imported %d boundaries from %s", imported, o.Source)
+
+		assignBoundaries(ctx, di.db)
+	},
+}
+
+var geoAssignCmd = &cobra.Command{
+	Use:   "assign",
+	Short: "Place listings in the areas whose boundaries contain them",
+	Long: `Link the imported boundaries to the known areas and move every listing to
+the most specific area whose boundary contains it. Run after new areas are
+added, updates do this for the listings they save.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		di := setup()
+		assignBoundaries(cmd.Context(), di.db)
+	},
+}
+
+// assignBoundaries links the boundaries to areas and moves the listings into them
+func assignBoundaries(ctx context.Context, db *sql.DB) {
+	linked, err := geo.LinkAreas(ctx, db)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	moved, err := geo.AssignListings(ctx, db)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Linked %d boundaries to areas, moved %d listings", linked, moved)
+}
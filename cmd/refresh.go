@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"oikotie/database/models"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func init() {
+	refreshCmd.Flags().StringSlice("area", nil, "Refresh the listings in these areas, by name")
+	refreshCmd.Flags().String("older-than", "", "Refresh the listings last accessed longer ago than this, e.g. 7d or 12h")
+	addFetcherFlags(refreshCmd)
+	rootCmd.AddCommand(refreshCmd)
+}
+
+var refreshCmd = &cobra.Command{
+	Use:   "refresh [external-id...]",
+	Short: "Refetch details and images of stored listings",
+	Long: `Refetch the detail pages and images of stored listings, given by external id
+or matched by --area and --older-than. Listings marked removed are only
+refreshed when given by external id.
+
+A snapshot is recorded only when the card or the details changed. Listings
+that fail to fetch are logged and skipped, listings whose page is gone are
+marked removed.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		di := setup()
+		ctx := cmd.Context()
+
+		mods := []qm.QueryMod{}
+
+		if len(args) > 0 {
+			ids := make([]int, len(args))
+			for i, arg := range args {
+				id, err := strconv.Atoi(arg)
+				if err != nil {
+					log.Fatalf("Invalid external id %q", arg)
+				}
+				ids[i] = id
+			}
+			mods = append(mods, models.ListingWhere.ExternalID.IN(ids))
+		} else {
+			mods = append(mods, models.ListingWhere.RemovedAt.IsNull())
+		}
+
+		areaNames, _ := cmd.Flags().GetStringSlice("area")
+		if len(areaNames) > 0 {
+			areaIDs, err := areaIDsByName(ctx, di.db, areaNames)
+			if err != nil {
+				log.Fatal(err)
+			}
+			mods = append(mods, models.ListingWhere.AreaID.IN(areaIDs))
+		}
+
+		olderThan, _ := cmd.Flags().GetString("older-than")
+		if olderThan != "" {
+			age, err := parseAge(olderThan)
+			if err != nil {
+				log.Fatalf("--older-than: %v", err)
+			}
+			mods = append(mods, models.ListingWhere.DateAccessed.LT(time.Now().Add(-age)))
+		}
+
+		if len(args) == 0 && len(areaNames) == 0 && olderThan == "" {
+			log.Fatal("Give external ids, --area or --older-than")
+		}
+
+		listings, err := models.Listings(mods...).All(ctx, di.db)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Refreshing %d listings", len(listings))
+
+		search, err := newScraper(cmd, di)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		result, err := search.Refresh(ctx, listings)
+		msg := fmt.Sprintf("Refreshed %d of %d listings, %d removed, %d failed",
+			len(result.Refreshed), len(listings), len(result.Removed), result.Failed)
+		if ctx.Err() != nil {
+			log.Print(msg)
+			os.Exit(exitInterrupted)
+		} else if err != nil {
+			log.Print(msg)
+			log.Fatal(err)
+		}
+		log.Print(msg)
+	},
+}
@@ -1,41 +1,209 @@
 package cmd
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"log"
+	"oikotie/database"
 	"oikotie/database/models"
 	"oikotie/scraper"
+	"reflect"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 func init() {
+	reparseCmd.Flags().Bool("only-failed", false, "Only reparse listings with parse errors")
+	reparseCmd.Flags().StringSlice("area", nil, "Only reparse listings in these areas, by name")
+	reparseCmd.Flags().String("since", "", "Only reparse listings accessed on or after this date, YYYY-MM-DD")
+	reparseCmd.Flags().IntSlice("id", nil, "Reparse these listings, by id, whatever their parser version")
+	reparseCmd.Flags().Bool("dry-run", false, "Show what would change without saving")
+	reparseCmd.Flags().Int("batch-size", 500, "Listings reparsed per transaction")
 	rootCmd.AddCommand(reparseCmd)
 }
 
 var reparseCmd = &cobra.Command{
 	Use:   "reparse",
 	Short: "Reparse raw data",
+	Long: `Reparse raw data of listings derived by an older parser version.
+--id and --only-failed reparse the matching listings whatever their version.
+Listings marked removed are reparsed too, so that their columns stay
+comparable with the active ones.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		di := setup()
 
-		listings, err := models.Listings().All(di.db)
+		ctx := cmd.Context()
+		mods, err := reparseFilters(ctx, cmd, di.db)
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		for _, listing := range listings {
-			err = scraper.SetDerivedFields(listing)
-			if err != nil {
-				log.Printf("Error parsing listing (%d), skipping. %v", listing.ID, err)
-			}
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		batchSize, _ := cmd.Flags().GetInt("batch-size")
+		if batchSize < 1 {
+			log.Fatal("--batch-size must be at least 1")
+		}
+
+		reparsed, failed, changed := 0, 0, 0
+		lastID := 0
+		for {
+			var listings models.ListingSlice
+
+			err = transaction.Do(ctx, di.db, func(tx *sql.Tx) error {
+				batch := append([]qm.QueryMod{
+					models.ListingWhere.ID.GT(lastID),
+					qm.OrderBy(models.ListingColumns.ID),
+					qm.Limit(batchSize),
+				}, mods...)
+
+				listings, err = models.Listings(batch...).All(ctx, tx)
+				if err != nil {
+					return err
+				}
+
+				for _, listing := range listings {
+					before := *listing
+
+					err := scraper.SetDerivedFields(listing)
+					if err != nil {
+						failed++
+						log.Printf("Error parsing listing (%d): %v", listing.ID, err)
+					}
+
+					diff := listingDiff(&before, listing)
+					if len(diff) > 0 {
+						changed++
+					}
+
+					if dryRun {
+						for _, line := range diff {
+							fmt.Printf("listing %d: %s\n", listing.ID, line)
+						}
+						continue
+					}
 
-			_, err = listing.Update(di.db, boil.Infer())
+					_, err = listing.Update(ctx, tx, boil.Infer())
+					if err != nil {
+						return err
+					}
+				}
+
+				return nil
+			})
 			if err != nil {
 				log.Fatal(err)
 			}
+
+			if len(listings) == 0 {
+				break
+			}
+			reparsed += len(listings)
+			lastID = listings[len(listings)-1].ID
 		}
 
-		log.Printf("Reparsed %d listings", len(listings))
+		if dryRun {
+			log.Printf("Dry run, would reparse %d listings, %d changed, %d with parse errors", reparsed, changed, failed)
+			return
+		}
+		log.Printf("Reparsed %d listings, %d changed, %d with parse errors", reparsed, changed, failed)
 	},
 }
+
+// reparseFilters builds the query mods selecting the listings to reparse
+func reparseFilters(ctx context.Context, cmd *cobra.Command, db *sql.DB) ([]qm.QueryMod, error) {
+	mods := []qm.QueryMod{}
+
+	ids, _ := cmd.Flags().GetIntSlice("id")
+	onlyFailed, _ := cmd.Flags().GetBool("only-failed")
+
+	if len(ids) > 0 {
+		mods = append(mods, models.ListingWhere.ID.IN(ids))
+	}
+	if onlyFailed {
+		mods = append(mods, models.ListingWhere.ParseErrors.IsNotNull())
+	}
+	if len(ids) == 0 && !onlyFailed {
+		mods = append(mods, models.ListingWhere.ParserVersion.LT(scraper.ParserVersion))
+	}
+
+	areaNames, _ := cmd.Flags().GetStringSlice("area")
+	if len(areaNames) > 0 {
+		areaIDs, err := areaIDsByName(ctx, db, areaNames)
+		if err != nil {
+			return nil, err
+		}
+		mods = append(mods, models.ListingWhere.AreaID.IN(areaIDs))
+	}
+
+	since, _ := cmd.Flags().GetString("since")
+	if since != "" {
+		t, err := time.ParseInLocation("2006-01-02", since, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("--since: %w", err)
+		}
+		mods = append(mods, models.ListingWhere.DateAccessed.GTE(t))
+	}
+
+	return mods, nil
+}
+
+// listingDiff lists the columns that differ between two versions of a listing
+// as "column: old -> new"
+func listingDiff(a *models.Listing, b *models.Listing) []string {
+	diff := []string{}
+
+	av := reflect.ValueOf(a).Elem()
+	bv := reflect.ValueOf(b).Elem()
+	for i := 0; i < av.NumField(); i++ {
+		column := av.Type().Field(i).Tag.Get("boil")
+		if column == "" || column == "-" {
+			continue
+		}
+
+		before := columnValue(av.Field(i).Interface())
+		after := columnValue(bv.Field(i).Interface())
+		if before != after {
+			diff = append(diff, fmt.Sprintf("%s: %s -> %s", column, before, after))
+		}
+	}
+
+	return diff
+}
+
+// columnValue formats a field the way it's stored. JSON is normalized, JSONB
+// read from the database isn't formatted the way it was written
+func columnValue(v interface{}) string {
+	if j, ok := v.(null.JSON); ok && j.Valid {
+		var value interface{}
+		if j.Unmarshal(&value) == nil {
+			normalized, _ := json.Marshal(value)
+			return string(normalized)
+		}
+	}
+
+	if valuer, ok := v.(driver.Valuer); ok {
+		value, err := valuer.Value()
+		if err != nil {
+			return fmt.Sprintf("<%v>", err)
+		}
+		v = value
+	}
+
+	switch value := v.(type) {
+	case nil:
+		return "null"
+	case []byte:
+		return string(value)
+	case time.Time:
+		return value.Format(time.RFC3339)
+	}
+
+	return fmt.Sprint(v)
+}
@@ -1,11 +1,14 @@
 package cmd
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"oikotie/config"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
 )
@@ -18,13 +21,40 @@ var rootCmd = &cobra.Command{
 	// },
 }
 
+// exitInterrupted is the exit status of a command stopped by SIGINT or SIGTERM
+const exitInterrupted = 130
+
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	ctx, cancel := signalContext()
+	defer cancel()
+
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
 }
 
+// signalContext returns a context that is cancelled on the first SIGINT or
+// SIGTERM. Later signals get the default behaviour and kill the process
+func signalContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case sig := <-signals:
+			log.Printf("Received %s, shutting down", sig)
+			cancel()
+		case <-ctx.Done():
+		}
+		signal.Stop(signals)
+	}()
+
+	return ctx, cancel
+}
+
 type DI struct {
 	cfg *config.Reader
 	db  *sql.DB
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"oikotie/database/models"
+	"oikotie/scraper"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func init() {
+	searchCmd.Flags().String("near", "", "Search around this point, as latitude,longitude, e.g. 60.17,24.94")
+	searchCmd.Flags().String("within", "1km", "Search radius, e.g. 1.5km or 800m")
+	searchCmd.Flags().Int("limit", 50, "Maximum number of listings, 0 for no limit")
+	searchCmd.Flags().Bool("removed", false, "Include listings marked removed")
+	_ = searchCmd.MarkFlagRequired("near")
+	rootCmd.AddCommand(searchCmd)
+}
+
+var searchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Search stored listings",
+	Long: `Search stored listings around a point, nearest first, e.g.
+ot search --near "60.17,24.94" --within 1.5km
+Listings marked removed are left out unless --removed is given.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		di := setup()
+		ctx := cmd.Context()
+
+		near, _ := cmd.Flags().GetString("near")
+		point, err := parsePoint(near)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		within, _ := cmd.Flags().GetString("within")
+		meters, err := parseDistance(within)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		mods := []qm.QueryMod{}
+		if removed, _ := cmd.Flags().GetBool("removed"); !removed {
+			mods = append(mods, models.ListingWhere.RemovedAt.IsNull())
+		}
+		if limit, _ := cmd.Flags().GetInt("limit"); limit > 0 {
+			mods = append(mods, qm.Limit(limit))
+		}
+
+		listings, err := scraper.ListingsNear(ctx, di.db, point, meters, mods...)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		areaIDs := []int{}
+		for _, l := range listings {
+			areaIDs = append(areaIDs, l.AreaID)
+		}
+		areas, err := models.Areas(models.AreaWhere.ID.IN(areaIDs)).All(ctx, di.db)
+		if err != nil {
+			log.Fatal(err)
+		}
+		areaNames := map[int]string{}
+		for _, a := range areas {
+			areaNames[a.ID] = a.Name
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "DISTANCE\tEXTERNAL ID\tPRICE\tSIZE\tROOMS\tAREA\t")
+		for _, l := range listings {
+			rooms := "-"
+			if l.Rooms.Valid {
+				rooms = strconv.Itoa(l.Rooms.Int)
+			}
+			fmt.Fprintf(w, "%s\t%d\t%d\t%g\t%s\t%s\t\n",
+				formatDistance(l.Distance), l.ExternalID, l.Price, l.Size, rooms, areaNames[l.AreaID])
+		}
+		w.Flush()
+	},
+}
+
+func formatDistance(meters float64) string {
+	if meters < 1000 {
+		return fmt.Sprintf("%.0f m", meters)
+	}
+	return fmt.Sprintf("%.1f km", meters/1000)
+}
@@ -1,41 +1,97 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"oikotie/database/models"
+	"oikotie/geo"
 	"oikotie/scraper"
 	"oikotie/tg"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
+var updateFull bool
+
+// sendTimeout bounds sending the summary of an interrupted update
+const sendTimeout = 10 * time.Second
+
 func init() {
+	updateCmd.Flags().BoolVar(&updateFull, "full", false, "Refetch details and images of every listing and scan each area fully")
+	updateCmd.Flags().String("full-scan-every", "7d", "Scan an area fully when its last full scan is older than this, e.g. 7d or 12h, 0 for never")
+	addFetcherFlags(updateCmd)
 	rootCmd.AddCommand(updateCmd)
 }
 
 var updateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "Scrape Oikotie and update data",
+	Long: `Scrape Oikotie and update data.
+
+Runs are incremental by default: the details and images of listings whose card
+hasn't changed aren't refetched, and paging through an area stops at a run of
+known listings. Removed listings are only detected when an area's whole result
+set is scanned, so an incremental run scans an area fully when its last full
+scan is older than --full-scan-every. Until then removals go unnoticed, a
+shorter interval reports them sooner at the cost of paging through every card.
+--full also refetches the details and images of every listing.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		log.Println("Running Oikotie update")
 		di := setup()
 
-		search := scraper.Create(di.db).SetAreaCodes(di.cfg.SearchConfig().Areas)
-		if p := di.cfg.SearchConfig().Price; p != nil {
-			search.SetPrice(p.Min, p.Max)
+		search, err := newScraper(cmd, di)
+		if err != nil {
+			log.Fatal(err)
 		}
-		if s := di.cfg.SearchConfig().Size; s != nil {
-			search.SetSize(s.Min, s.Max)
+		search.SetFull(updateFull)
+
+		fullScanEvery, _ := cmd.Flags().GetString("full-scan-every")
+		every := time.Duration(0)
+		if fullScanEvery != "0" {
+			every, err = parseAge(fullScanEvery)
+			if err != nil {
+				log.Fatal(err)
+			}
 		}
+		search.SetFullScanEvery(every)
 
-		l, err := search.Run()
-		if err != nil {
+		priceChanges := []scraper.PriceChange{}
+		search.OnPriceChange(func(c scraper.PriceChange) {
+			priceChanges = append(priceChanges, c)
+		})
+
+		removed := 0
+		search.OnRemoved(func(_ *models.Listing) {
+			removed++
+		})
+
+		ctx := cmd.Context()
+		l, err := search.Run(ctx)
+		if ctx.Err() != nil {
+			// The run context is cancelled, send the partial summary with a fresh one
+			msg := fmt.Sprintf("Update interrupted, saved %d listings, %d removed\n", len(l), removed)
+			msg += priceChangeSummary(priceChanges)
+			sendCtx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+			defer cancel()
+			err := tg.SendMessage(sendCtx, di.cfg, msg)
+			if err != nil {
+				log.Printf("SendMessage failed: %v", err)
+			}
+
+			log.Print(msg)
+			os.Exit(exitInterrupted)
+		} else if err != nil {
 			msg := fmt.Sprintf("Oikotie scraper failed with error: %v", err)
-			_ = tg.SendMessage(di.cfg, msg)
+			_ = tg.SendMessage(ctx, di.cfg, msg)
 			log.Fatal(err)
 		} else {
-			msg := fmt.Sprintf("Update successfull, created %d listings\n", len(l))
-			err := tg.SendMessage(di.cfg, msg)
+			msg := fmt.Sprintf("Update successfull, saved %d listings, %d removed\n", len(l), removed)
+			msg += priceChangeSummary(priceChanges)
+			err := tg.SendMessage(ctx, di.cfg, msg)
 			if err != nil {
 				log.Printf("SendMessage failed: %v", err)
 			}
@@ -44,3 +100,62 @@ var updateCmd = &cobra.Command{
 		}
 	},
 }
+
+// newScraper creates a scraper configured from the search config and the fetcher flags
+func newScraper(cmd *cobra.Command, di DI) (*scraper.Scraper, error) {
+	areas := make([]scraper.AreaQuery, len(di.cfg.SearchConfig().Areas))
+	for i, a := range di.cfg.SearchConfig().Areas {
+		areas[i] = scraper.AreaQuery{Query: a.Query, CardID: a.CardID}
+	}
+
+	fences := make([]geo.Geofence, len(di.cfg.SearchConfig().Geofences))
+	for i, g := range di.cfg.SearchConfig().Geofences {
+		fences[i] = geo.Geofence{Name: g.Name, Geometry: g.Geometry}
+	}
+
+	search := scraper.Create(di.db).SetAreas(areas)
+	search.SetTrackedAreas(di.cfg.SearchConfig().AreasFromDB)
+	search.SetGeofences(fences)
+	if p := di.cfg.SearchConfig().Price; p != nil {
+		search.SetPrice(p.Min, p.Max)
+	}
+	if s := di.cfg.SearchConfig().Size; s != nil {
+		search.SetSize(s.Min, s.Max)
+	}
+	search.SetKind(di.cfg.SearchConfig().Kind)
+	search.SetFilters(di.cfg.SearchConfig().Filters)
+	search.SetMaxListingsPerArea(di.cfg.SearchConfig().MaxListingsPerArea)
+	if c := di.cfg.SearchConfig().Concurrency; c != nil {
+		search.SetConcurrency(scraper.Concurrency{
+			Cards:   c.Cards,
+			Fence:   c.Fence,
+			Locate:  c.Locate,
+			Details: c.Details,
+			Derive:  c.Derive,
+			Persist: c.Persist,
+			Images:  c.Images,
+		})
+	}
+	fetcherOpts, err := fetcherOptions(cmd, di.cfg.SearchConfig())
+	if err != nil {
+		return nil, err
+	}
+	search.SetFetcherOptions(fetcherOpts)
+	search.SetBaseURL(baseURL(cmd, di.cfg.SearchConfig()))
+
+	return search, nil
+}
+
+func priceChangeSummary(changes []scraper.PriceChange) string {
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d price changes:\n", len(changes))
+	for _, c := range changes {
+		fmt.Fprintf(&b, "%d: %d € -> %d € (%+.1f%%)\n", c.Listing.ExternalID, c.OldPrice, c.NewPrice, c.Percentage())
+	}
+
+	return b.String()
+}
@@ -4,13 +4,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"oikotie/filter"
 	"os"
 
 	"github.com/joho/godotenv"
 )
 
 type SearchConfig struct {
-	Areas []string `json:"areas"`
+	Areas []Area `json:"areas"`
 	Price *struct {
 		Min int `json:"min"`
 		Max int `json:"max"`
@@ -19,6 +20,82 @@ type SearchConfig struct {
 		Min int `json:"min"`
 		Max int `json:"max"`
 	} `json:"size"`
+	// Kind is "sale", "rent" or "plot", missing means sale
+	Kind    filter.Kind    `json:"kind"`
+	Filters filter.Filters `json:"filters"`
+	// MaxListingsPerArea caps how many listings are fetched per area, 0 or missing means no cap
+	MaxListingsPerArea int `json:"maxListingsPerArea"`
+	Concurrency        *struct {
+		Cards   int `json:"cards"`
+		Fence   int `json:"fence"`
+		Locate  int `json:"locate"`
+		Details int `json:"details"`
+		Derive  int `json:"derive"`
+		Persist int `json:"persist"`
+		Images  int `json:"images"`
+	} `json:"concurrency"`
+	// RequestsPerSecond limits requests per host, 0 or missing uses the scraper default
+	// and a negative value disables the limit
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+	// BaseURL overrides the scraped site, e.g. to run against ot fakeserver
+	BaseURL string `json:"baseURL"`
+	// HTTP durations are Go duration strings, e.g. "30s" or "5m"
+	HTTP *struct {
+		RetryMax     *int   `json:"retryMax"`
+		RetryWaitMin string `json:"retryWaitMin"`
+		RetryWaitMax string `json:"retryWaitMax"`
+		// RetryAfterMax is the longest Retry-After wait honoured
+		RetryAfterMax string `json:"retryAfterMax"`
+		Timeout       string `json:"timeout"`
+		UserAgent     string `json:"userAgent"`
+	} `json:"http"`
+	// AreasFromDB scrapes the areas added with ot areas add instead of Areas
+	AreasFromDB bool `json:"areasFromDB"`
+	// Geofences are named polygons searched in addition to the areas
+	Geofences []Geofence `json:"geofences"`
+}
+
+// Geofence is a named GeoJSON Polygon or MultiPolygon in WGS84 coordinates,
+// e.g. {"name": "Inner city", "geometry": {"type": "Polygon", "coordinates": [...]}}
+type Geofence struct {
+	Name     string          `json:"name"`
+	Geometry json.RawMessage `json:"geometry"`
+}
+
+// Validate checks that the geofence is named and its geometry is a polygon
+func (g Geofence) Validate() error {
+	if g.Name == "" {
+		return fmt.Errorf("a geofence needs a name")
+	}
+
+	var geometry struct {
+		Type string `json:"type"`
+	}
+	err := json.Unmarshal(g.Geometry, &geometry)
+	if err != nil || (geometry.Type != "Polygon" && geometry.Type != "MultiPolygon") {
+		return fmt.Errorf("geofence %q needs a Polygon or a MultiPolygon geometry", g.Name)
+	}
+
+	return nil
+}
+
+// Area is a location search query, e.g. a postcode or "Otaniemi, Espoo", given
+// as a string, or an object pinning the query to one of its cards by card id:
+// {"query": "Otaniemi", "cardId": <card id>}. The candidates are listed by ot areas search
+type Area struct {
+	Query  string `json:"query"`
+	CardID int    `json:"cardId"`
+}
+
+func (a *Area) UnmarshalJSON(b []byte) error {
+	var query string
+	if json.Unmarshal(b, &query) == nil {
+		*a = Area{Query: query}
+		return nil
+	}
+
+	type area Area
+	return json.Unmarshal(b, (*area)(a))
 }
 
 type Reader struct {
@@ -72,6 +149,34 @@ func (r *Reader) SearchConfig() *SearchConfig {
 		if err != nil {
 			panic(fmt.Errorf("Failed to parse config, %w", err))
 		}
+
+		err = r.searchConfig.Kind.Validate()
+		if err != nil {
+			panic(fmt.Errorf("Invalid config kind, %w", err))
+		}
+
+		err = r.searchConfig.Filters.Validate()
+		if err != nil {
+			panic(fmt.Errorf("Invalid config filters, %w", err))
+		}
+
+		for _, area := range r.searchConfig.Areas {
+			if area.Query == "" && area.CardID == 0 {
+				panic(fmt.Errorf("Invalid config areas, an area needs a query or a card id"))
+			}
+		}
+
+		names := map[string]bool{}
+		for _, fence := range r.searchConfig.Geofences {
+			err = fence.Validate()
+			if err != nil {
+				panic(fmt.Errorf("Invalid config geofences, %w", err))
+			}
+			if names[fence.Name] {
+				panic(fmt.Errorf("Invalid config geofences, %q is given twice", fence.Name))
+			}
+			names[fence.Name] = true
+		}
 	}
 
 	return r.searchConfig
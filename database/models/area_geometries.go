@@ -0,0 +1,968 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AreaGeometry is an object representing the database table.
+type AreaGeometry struct {
+	ID         int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	AreaID     null.Int  `boil:"area_id" json:"area_id,omitempty" toml:"area_id" yaml:"area_id,omitempty"`
+	CardType   int       `boil:"card_type" json:"card_type" toml:"card_type" yaml:"card_type"`
+	Code       string    `boil:"code" json:"code" toml:"code" yaml:"code"`
+	Name       string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	City       string    `boil:"city" json:"city" toml:"city" yaml:"city"`
+	Source     string    `boil:"source" json:"source" toml:"source" yaml:"source"`
+	ImportedAt time.Time `boil:"imported_at" json:"imported_at" toml:"imported_at" yaml:"imported_at"`
+
+	R *areaGeometryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L areaGeometryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AreaGeometryColumns = struct {
+	ID         string
+	AreaID     string
+	CardType   string
+	Code       string
+	Name       string
+	City       string
+	Source     string
+	ImportedAt string
+}{
+	ID:         "id",
+	AreaID:     "area_id",
+	CardType:   "card_type",
+	Code:       "code",
+	Name:       "name",
+	City:       "city",
+	Source:     "source",
+	ImportedAt: "imported_at",
+}
+
+// Generated where
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var AreaGeometryWhere = struct {
+	ID         whereHelperint
+	AreaID     whereHelpernull_Int
+	CardType   whereHelperint
+	Code       whereHelperstring
+	Name       whereHelperstring
+	City       whereHelperstring
+	Source     whereHelperstring
+	ImportedAt whereHelpertime_Time
+}{
+	ID:         whereHelperint{field: "\"area_geometries\".\"id\""},
+	AreaID:     whereHelpernull_Int{field: "\"area_geometries\".\"area_id\""},
+	CardType:   whereHelperint{field: "\"area_geometries\".\"card_type\""},
+	Code:       whereHelperstring{field: "\"area_geometries\".\"code\""},
+	Name:       whereHelperstring{field: "\"area_geometries\".\"name\""},
+	City:       whereHelperstring{field: "\"area_geometries\".\"city\""},
+	Source:     whereHelperstring{field: "\"area_geometries\".\"source\""},
+	ImportedAt: whereHelpertime_Time{field: "\"area_geometries\".\"imported_at\""},
+}
+
+// AreaGeometryRels is where relationship names are stored.
+var AreaGeometryRels = struct {
+	Area string
+}{
+	Area: "Area",
+}
+
+// areaGeometryR is where relationships are stored.
+type areaGeometryR struct {
+	Area *Area `boil:"Area" json:"Area" toml:"Area" yaml:"Area"`
+}
+
+// NewStruct creates a new relationship struct
+func (*areaGeometryR) NewStruct() *areaGeometryR {
+	return &areaGeometryR{}
+}
+
+// areaGeometryL is where Load methods for each relationship are stored.
+type areaGeometryL struct{}
+
+var (
+	areaGeometryAllColumns            = []string{"id", "area_id", "card_type", "code", "name", "city", "source", "imported_at"}
+	areaGeometryColumnsWithoutDefault = []string{"area_id", "card_type", "code", "name", "source"}
+	areaGeometryColumnsWithDefault    = []string{"id", "city", "imported_at"}
+	areaGeometryPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// AreaGeometrySlice is an alias for a slice of pointers to AreaGeometry.
+	// This should generally be used opposed to []AreaGeometry.
+	AreaGeometrySlice []*AreaGeometry
+
+	areaGeometryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	areaGeometryType                 = reflect.TypeOf(&AreaGeometry{})
+	areaGeometryMapping              = queries.MakeStructMapping(areaGeometryType)
+	areaGeometryPrimaryKeyMapping, _ = queries.BindMapping(areaGeometryType, areaGeometryMapping, areaGeometryPrimaryKeyColumns)
+	areaGeometryInsertCacheMut       sync.RWMutex
+	areaGeometryInsertCache          = make(map[string]insertCache)
+	areaGeometryUpdateCacheMut       sync.RWMutex
+	areaGeometryUpdateCache          = make(map[string]updateCache)
+	areaGeometryUpsertCacheMut       sync.RWMutex
+	areaGeometryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single areaGeometry record from the query.
+func (q areaGeometryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AreaGeometry, error) {
+	o := &AreaGeometry{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for area_geometries")
+	}
+
+	return o, nil
+}
+
+// All returns all AreaGeometry records from the query.
+func (q areaGeometryQuery) All(ctx context.Context, exec boil.ContextExecutor) (AreaGeometrySlice, error) {
+	var o []*AreaGeometry
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to AreaGeometry slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AreaGeometry records in the query.
+func (q areaGeometryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count area_geometries rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q areaGeometryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if area_geometries exists")
+	}
+
+	return count > 0, nil
+}
+
+// Area pointed to by the foreign key.
+func (o *AreaGeometry) Area(mods ...qm.QueryMod) areaQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AreaID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Areas(queryMods...)
+	queries.SetFrom(query.Query, "\"areas\"")
+
+	return query
+}
+
+// LoadArea allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (areaGeometryL) LoadArea(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAreaGeometry interface{}, mods queries.Applicator) error {
+	var slice []*AreaGeometry
+	var object *AreaGeometry
+
+	if singular {
+		object = maybeAreaGeometry.(*AreaGeometry)
+	} else {
+		slice = *maybeAreaGeometry.(*[]*AreaGeometry)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &areaGeometryR{}
+		}
+		if !queries.IsNil(object.AreaID) {
+			args = append(args, object.AreaID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &areaGeometryR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.AreaID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.AreaID) {
+				args = append(args, obj.AreaID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`areas`),
+		qm.WhereIn(`areas.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Area")
+	}
+
+	var resultSlice []*Area
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Area")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for areas")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for areas")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Area = foreign
+		if foreign.R == nil {
+			foreign.R = &areaR{}
+		}
+		foreign.R.AreaGeometries = append(foreign.R.AreaGeometries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AreaID, foreign.ID) {
+				local.R.Area = foreign
+				if foreign.R == nil {
+					foreign.R = &areaR{}
+				}
+				foreign.R.AreaGeometries = append(foreign.R.AreaGeometries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetArea of the areaGeometry to the related item.
+// Sets o.R.Area to related.
+// Adds o to related.R.AreaGeometries.
+func (o *AreaGeometry) SetArea(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Area) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"area_geometries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"area_id"}),
+		strmangle.WhereClause("\"", "\"", 2, areaGeometryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AreaID, related.ID)
+	if o.R == nil {
+		o.R = &areaGeometryR{
+			Area: related,
+		}
+	} else {
+		o.R.Area = related
+	}
+
+	if related.R == nil {
+		related.R = &areaR{
+			AreaGeometries: AreaGeometrySlice{o},
+		}
+	} else {
+		related.R.AreaGeometries = append(related.R.AreaGeometries, o)
+	}
+
+	return nil
+}
+
+// RemoveArea relationship.
+// Sets o.R.Area to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *AreaGeometry) RemoveArea(ctx context.Context, exec boil.ContextExecutor, related *Area) error {
+	var err error
+
+	queries.SetScanner(&o.AreaID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("area_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Area = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AreaGeometries {
+		if queries.Equal(o.AreaID, ri.AreaID) {
+			continue
+		}
+
+		ln := len(related.R.AreaGeometries)
+		if ln > 1 && i < ln-1 {
+			related.R.AreaGeometries[i] = related.R.AreaGeometries[ln-1]
+		}
+		related.R.AreaGeometries = related.R.AreaGeometries[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AreaGeometries retrieves all the records using an executor.
+func AreaGeometries(mods ...qm.QueryMod) areaGeometryQuery {
+	mods = append(mods, qm.From("\"area_geometries\""))
+	return areaGeometryQuery{NewQuery(mods...)}
+}
+
+// FindAreaGeometry retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAreaGeometry(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*AreaGeometry, error) {
+	areaGeometryObj := &AreaGeometry{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"area_geometries\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, areaGeometryObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from area_geometries")
+	}
+
+	return areaGeometryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AreaGeometry) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no area_geometries provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(areaGeometryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	areaGeometryInsertCacheMut.RLock()
+	cache, cached := areaGeometryInsertCache[key]
+	areaGeometryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			areaGeometryAllColumns,
+			areaGeometryColumnsWithDefault,
+			areaGeometryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(areaGeometryType, areaGeometryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(areaGeometryType, areaGeometryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"area_geometries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"area_geometries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into area_geometries")
+	}
+
+	if !cached {
+		areaGeometryInsertCacheMut.Lock()
+		areaGeometryInsertCache[key] = cache
+		areaGeometryInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the AreaGeometry.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AreaGeometry) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	areaGeometryUpdateCacheMut.RLock()
+	cache, cached := areaGeometryUpdateCache[key]
+	areaGeometryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			areaGeometryAllColumns,
+			areaGeometryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update area_geometries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"area_geometries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, areaGeometryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(areaGeometryType, areaGeometryMapping, append(wl, areaGeometryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update area_geometries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for area_geometries")
+	}
+
+	if !cached {
+		areaGeometryUpdateCacheMut.Lock()
+		areaGeometryUpdateCache[key] = cache
+		areaGeometryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q areaGeometryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for area_geometries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for area_geometries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AreaGeometrySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), areaGeometryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"area_geometries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, areaGeometryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in areaGeometry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all areaGeometry")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AreaGeometry) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no area_geometries provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(areaGeometryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	areaGeometryUpsertCacheMut.RLock()
+	cache, cached := areaGeometryUpsertCache[key]
+	areaGeometryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			areaGeometryAllColumns,
+			areaGeometryColumnsWithDefault,
+			areaGeometryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			areaGeometryAllColumns,
+			areaGeometryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert area_geometries, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(areaGeometryPrimaryKeyColumns))
+			copy(conflict, areaGeometryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"area_geometries\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(areaGeometryType, areaGeometryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(areaGeometryType, areaGeometryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert area_geometries")
+	}
+
+	if !cached {
+		areaGeometryUpsertCacheMut.Lock()
+		areaGeometryUpsertCache[key] = cache
+		areaGeometryUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single AreaGeometry record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AreaGeometry) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no AreaGeometry provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), areaGeometryPrimaryKeyMapping)
+	sql := "DELETE FROM \"area_geometries\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from area_geometries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for area_geometries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q areaGeometryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no areaGeometryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from area_geometries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for area_geometries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AreaGeometrySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), areaGeometryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"area_geometries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, areaGeometryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from areaGeometry slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for area_geometries")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AreaGeometry) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAreaGeometry(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AreaGeometrySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AreaGeometrySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), areaGeometryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"area_geometries\".* FROM \"area_geometries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, areaGeometryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in AreaGeometrySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AreaGeometryExists checks if the AreaGeometry row exists.
+func AreaGeometryExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"area_geometries\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if area_geometries exists")
+	}
+
+	return exists, nil
+}
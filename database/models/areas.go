@@ -4,6 +4,7 @@
 package models
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"reflect"
@@ -13,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -22,102 +24,148 @@ import (
 
 // Area is an object representing the database table.
 type Area struct {
-	ID         int    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	ExternalID int    `boil:"external_id" json:"external_id" toml:"external_id" yaml:"external_id"`
-	Name       string `boil:"name" json:"name" toml:"name" yaml:"name"`
-	City       string `boil:"city" json:"city" toml:"city" yaml:"city"`
-	CardType   int    `boil:"card_type" json:"card_type" toml:"card_type" yaml:"card_type"`
+	ID             int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExternalID     int         `boil:"external_id" json:"external_id" toml:"external_id" yaml:"external_id"`
+	Name           string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	City           string      `boil:"city" json:"city" toml:"city" yaml:"city"`
+	CardType       int         `boil:"card_type" json:"card_type" toml:"card_type" yaml:"card_type"`
+	Query          null.String `boil:"query" json:"query,omitempty" toml:"query" yaml:"query,omitempty"`
+	Tracked        bool        `boil:"tracked" json:"tracked" toml:"tracked" yaml:"tracked"`
+	LastScrapedAt  null.Time   `boil:"last_scraped_at" json:"last_scraped_at,omitempty" toml:"last_scraped_at" yaml:"last_scraped_at,omitempty"`
+	ParentID       null.Int    `boil:"parent_id" json:"parent_id,omitempty" toml:"parent_id" yaml:"parent_id,omitempty"`
+	LastFullScanAt null.Time   `boil:"last_full_scan_at" json:"last_full_scan_at,omitempty" toml:"last_full_scan_at" yaml:"last_full_scan_at,omitempty"`
 
 	R *areaR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L areaL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var AreaColumns = struct {
-	ID         string
-	ExternalID string
-	Name       string
-	City       string
-	CardType   string
+	ID             string
+	ExternalID     string
+	Name           string
+	City           string
+	CardType       string
+	Query          string
+	Tracked        string
+	LastScrapedAt  string
+	ParentID       string
+	LastFullScanAt string
 }{
-	ID:         "id",
-	ExternalID: "external_id",
-	Name:       "name",
-	City:       "city",
-	CardType:   "card_type",
+	ID:             "id",
+	ExternalID:     "external_id",
+	Name:           "name",
+	City:           "city",
+	CardType:       "card_type",
+	Query:          "query",
+	Tracked:        "tracked",
+	LastScrapedAt:  "last_scraped_at",
+	ParentID:       "parent_id",
+	LastFullScanAt: "last_full_scan_at",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
+type whereHelpernull_String struct{ field string }
 
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
-type whereHelperstring struct{ field string }
+type whereHelperbool struct{ field string }
 
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
 var AreaWhere = struct {
-	ID         whereHelperint
-	ExternalID whereHelperint
-	Name       whereHelperstring
-	City       whereHelperstring
-	CardType   whereHelperint
+	ID             whereHelperint
+	ExternalID     whereHelperint
+	Name           whereHelperstring
+	City           whereHelperstring
+	CardType       whereHelperint
+	Query          whereHelpernull_String
+	Tracked        whereHelperbool
+	LastScrapedAt  whereHelpernull_Time
+	ParentID       whereHelpernull_Int
+	LastFullScanAt whereHelpernull_Time
 }{
-	ID:         whereHelperint{field: "\"areas\".\"id\""},
-	ExternalID: whereHelperint{field: "\"areas\".\"external_id\""},
-	Name:       whereHelperstring{field: "\"areas\".\"name\""},
-	City:       whereHelperstring{field: "\"areas\".\"city\""},
-	CardType:   whereHelperint{field: "\"areas\".\"card_type\""},
+	ID:             whereHelperint{field: "\"areas\".\"id\""},
+	ExternalID:     whereHelperint{field: "\"areas\".\"external_id\""},
+	Name:           whereHelperstring{field: "\"areas\".\"name\""},
+	City:           whereHelperstring{field: "\"areas\".\"city\""},
+	CardType:       whereHelperint{field: "\"areas\".\"card_type\""},
+	Query:          whereHelpernull_String{field: "\"areas\".\"query\""},
+	Tracked:        whereHelperbool{field: "\"areas\".\"tracked\""},
+	LastScrapedAt:  whereHelpernull_Time{field: "\"areas\".\"last_scraped_at\""},
+	ParentID:       whereHelpernull_Int{field: "\"areas\".\"parent_id\""},
+	LastFullScanAt: whereHelpernull_Time{field: "\"areas\".\"last_full_scan_at\""},
 }
 
 // AreaRels is where relationship names are stored.
 var AreaRels = struct {
-	Listings string
+	Parent          string
+	AreaGeometries  string
+	ParentAreas     string
+	ListingSearches string
+	Listings        string
 }{
-	Listings: "Listings",
+	Parent:          "Parent",
+	AreaGeometries:  "AreaGeometries",
+	ParentAreas:     "ParentAreas",
+	ListingSearches: "ListingSearches",
+	Listings:        "Listings",
 }
 
 // areaR is where relationships are stored.
 type areaR struct {
-	Listings ListingSlice `boil:"Listings" json:"Listings" toml:"Listings" yaml:"Listings"`
+	Parent          *Area              `boil:"Parent" json:"Parent" toml:"Parent" yaml:"Parent"`
+	AreaGeometries  AreaGeometrySlice  `boil:"AreaGeometries" json:"AreaGeometries" toml:"AreaGeometries" yaml:"AreaGeometries"`
+	ParentAreas     AreaSlice          `boil:"ParentAreas" json:"ParentAreas" toml:"ParentAreas" yaml:"ParentAreas"`
+	ListingSearches ListingSearchSlice `boil:"ListingSearches" json:"ListingSearches" toml:"ListingSearches" yaml:"ListingSearches"`
+	Listings        ListingSlice       `boil:"Listings" json:"Listings" toml:"Listings" yaml:"Listings"`
 }
 
 // NewStruct creates a new relationship struct
@@ -129,9 +177,9 @@ func (*areaR) NewStruct() *areaR {
 type areaL struct{}
 
 var (
-	areaAllColumns            = []string{"id", "external_id", "name", "city", "card_type"}
-	areaColumnsWithoutDefault = []string{"external_id", "name", "city", "card_type"}
-	areaColumnsWithDefault    = []string{"id"}
+	areaAllColumns            = []string{"id", "external_id", "name", "city", "card_type", "query", "tracked", "last_scraped_at", "parent_id", "last_full_scan_at"}
+	areaColumnsWithoutDefault = []string{"external_id", "name", "city", "card_type", "query", "last_scraped_at", "parent_id", "last_full_scan_at"}
+	areaColumnsWithDefault    = []string{"id", "tracked"}
 	areaPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -167,12 +215,12 @@ var (
 )
 
 // One returns a single area record from the query.
-func (q areaQuery) One(exec boil.Executor) (*Area, error) {
+func (q areaQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Area, error) {
 	o := &Area{}
 
 	queries.SetLimit(q.Query, 1)
 
-	err := q.Bind(nil, exec, o)
+	err := q.Bind(ctx, exec, o)
 	if err != nil {
 		if errors.Cause(err) == sql.ErrNoRows {
 			return nil, sql.ErrNoRows
@@ -184,10 +232,10 @@ func (q areaQuery) One(exec boil.Executor) (*Area, error) {
 }
 
 // All returns all Area records from the query.
-func (q areaQuery) All(exec boil.Executor) (AreaSlice, error) {
+func (q areaQuery) All(ctx context.Context, exec boil.ContextExecutor) (AreaSlice, error) {
 	var o []*Area
 
-	err := q.Bind(nil, exec, &o)
+	err := q.Bind(ctx, exec, &o)
 	if err != nil {
 		return nil, errors.Wrap(err, "models: failed to assign all query results to Area slice")
 	}
@@ -196,13 +244,13 @@ func (q areaQuery) All(exec boil.Executor) (AreaSlice, error) {
 }
 
 // Count returns the count of all Area records in the query.
-func (q areaQuery) Count(exec boil.Executor) (int64, error) {
+func (q areaQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
 	var count int64
 
 	queries.SetSelect(q.Query, nil)
 	queries.SetCount(q.Query)
 
-	err := q.Query.QueryRow(exec).Scan(&count)
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
 	if err != nil {
 		return 0, errors.Wrap(err, "models: failed to count areas rows")
 	}
@@ -211,14 +259,14 @@ func (q areaQuery) Count(exec boil.Executor) (int64, error) {
 }
 
 // Exists checks if the row exists in the table.
-func (q areaQuery) Exists(exec boil.Executor) (bool, error) {
+func (q areaQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
 	var count int64
 
 	queries.SetSelect(q.Query, nil)
 	queries.SetCount(q.Query)
 	queries.SetLimit(q.Query, 1)
 
-	err := q.Query.QueryRow(exec).Scan(&count)
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
 	if err != nil {
 		return false, errors.Wrap(err, "models: failed to check if areas exists")
 	}
@@ -226,6 +274,83 @@ func (q areaQuery) Exists(exec boil.Executor) (bool, error) {
 	return count > 0, nil
 }
 
+// Parent pointed to by the foreign key.
+func (o *Area) Parent(mods ...qm.QueryMod) areaQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ParentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Areas(queryMods...)
+	queries.SetFrom(query.Query, "\"areas\"")
+
+	return query
+}
+
+// AreaGeometries retrieves all the area_geometry's AreaGeometries with an executor.
+func (o *Area) AreaGeometries(mods ...qm.QueryMod) areaGeometryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"area_geometries\".\"area_id\"=?", o.ID),
+	)
+
+	query := AreaGeometries(queryMods...)
+	queries.SetFrom(query.Query, "\"area_geometries\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"area_geometries\".*"})
+	}
+
+	return query
+}
+
+// ParentAreas retrieves all the area's Areas with an executor via parent_id column.
+func (o *Area) ParentAreas(mods ...qm.QueryMod) areaQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"areas\".\"parent_id\"=?", o.ID),
+	)
+
+	query := Areas(queryMods...)
+	queries.SetFrom(query.Query, "\"areas\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"areas\".*"})
+	}
+
+	return query
+}
+
+// ListingSearches retrieves all the listing_search's ListingSearches with an executor.
+func (o *Area) ListingSearches(mods ...qm.QueryMod) listingSearchQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"listing_searches\".\"area_id\"=?", o.ID),
+	)
+
+	query := ListingSearches(queryMods...)
+	queries.SetFrom(query.Query, "\"listing_searches\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"listing_searches\".*"})
+	}
+
+	return query
+}
+
 // Listings retrieves all the listing's Listings with an executor.
 func (o *Area) Listings(mods ...qm.QueryMod) listingQuery {
 	var queryMods []qm.QueryMod
@@ -247,9 +372,109 @@ func (o *Area) Listings(mods ...qm.QueryMod) listingQuery {
 	return query
 }
 
-// LoadListings allows an eager lookup of values, cached into the
+// LoadParent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (areaL) LoadParent(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArea interface{}, mods queries.Applicator) error {
+	var slice []*Area
+	var object *Area
+
+	if singular {
+		object = maybeArea.(*Area)
+	} else {
+		slice = *maybeArea.(*[]*Area)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &areaR{}
+		}
+		if !queries.IsNil(object.ParentID) {
+			args = append(args, object.ParentID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &areaR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ParentID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ParentID) {
+				args = append(args, obj.ParentID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`areas`),
+		qm.WhereIn(`areas.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Area")
+	}
+
+	var resultSlice []*Area
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Area")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for areas")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for areas")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Parent = foreign
+		if foreign.R == nil {
+			foreign.R = &areaR{}
+		}
+		foreign.R.ParentAreas = append(foreign.R.ParentAreas, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ParentID, foreign.ID) {
+				local.R.Parent = foreign
+				if foreign.R == nil {
+					foreign.R = &areaR{}
+				}
+				foreign.R.ParentAreas = append(foreign.R.ParentAreas, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAreaGeometries allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (areaL) LoadListings(e boil.Executor, singular bool, maybeArea interface{}, mods queries.Applicator) error {
+func (areaL) LoadAreaGeometries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArea interface{}, mods queries.Applicator) error {
 	var slice []*Area
 	var object *Area
 
@@ -273,7 +498,7 @@ func (areaL) LoadListings(e boil.Executor, singular bool, maybeArea interface{},
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.ID) {
 					continue Outer
 				}
 			}
@@ -287,35 +512,35 @@ func (areaL) LoadListings(e boil.Executor, singular bool, maybeArea interface{},
 	}
 
 	query := NewQuery(
-		qm.From(`listings`),
-		qm.WhereIn(`listings.area_id in ?`, args...),
+		qm.From(`area_geometries`),
+		qm.WhereIn(`area_geometries.area_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
 	}
 
-	results, err := query.Query(e)
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load listings")
+		return errors.Wrap(err, "failed to eager load area_geometries")
 	}
 
-	var resultSlice []*Listing
+	var resultSlice []*AreaGeometry
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice listings")
+		return errors.Wrap(err, "failed to bind eager loaded slice area_geometries")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on listings")
+		return errors.Wrap(err, "failed to close results in eager load on area_geometries")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for listings")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for area_geometries")
 	}
 
 	if singular {
-		object.R.Listings = resultSlice
+		object.R.AreaGeometries = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &listingR{}
+				foreign.R = &areaGeometryR{}
 			}
 			foreign.R.Area = object
 		}
@@ -324,10 +549,10 @@ func (areaL) LoadListings(e boil.Executor, singular bool, maybeArea interface{},
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.AreaID {
-				local.R.Listings = append(local.R.Listings, foreign)
+			if queries.Equal(local.ID, foreign.AreaID) {
+				local.R.AreaGeometries = append(local.R.AreaGeometries, foreign)
 				if foreign.R == nil {
-					foreign.R = &listingR{}
+					foreign.R = &areaGeometryR{}
 				}
 				foreign.R.Area = local
 				break
@@ -338,16 +563,668 @@ func (areaL) LoadListings(e boil.Executor, singular bool, maybeArea interface{},
 	return nil
 }
 
+// LoadParentAreas allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (areaL) LoadParentAreas(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArea interface{}, mods queries.Applicator) error {
+	var slice []*Area
+	var object *Area
+
+	if singular {
+		object = maybeArea.(*Area)
+	} else {
+		slice = *maybeArea.(*[]*Area)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &areaR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &areaR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`areas`),
+		qm.WhereIn(`areas.parent_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load areas")
+	}
+
+	var resultSlice []*Area
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice areas")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on areas")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for areas")
+	}
+
+	if singular {
+		object.R.ParentAreas = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &areaR{}
+			}
+			foreign.R.Parent = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ParentID) {
+				local.R.ParentAreas = append(local.R.ParentAreas, foreign)
+				if foreign.R == nil {
+					foreign.R = &areaR{}
+				}
+				foreign.R.Parent = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListingSearches allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (areaL) LoadListingSearches(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArea interface{}, mods queries.Applicator) error {
+	var slice []*Area
+	var object *Area
+
+	if singular {
+		object = maybeArea.(*Area)
+	} else {
+		slice = *maybeArea.(*[]*Area)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &areaR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &areaR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`listing_searches`),
+		qm.WhereIn(`listing_searches.area_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load listing_searches")
+	}
+
+	var resultSlice []*ListingSearch
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice listing_searches")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on listing_searches")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for listing_searches")
+	}
+
+	if singular {
+		object.R.ListingSearches = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listingSearchR{}
+			}
+			foreign.R.Area = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AreaID {
+				local.R.ListingSearches = append(local.R.ListingSearches, foreign)
+				if foreign.R == nil {
+					foreign.R = &listingSearchR{}
+				}
+				foreign.R.Area = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (areaL) LoadListings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArea interface{}, mods queries.Applicator) error {
+	var slice []*Area
+	var object *Area
+
+	if singular {
+		object = maybeArea.(*Area)
+	} else {
+		slice = *maybeArea.(*[]*Area)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &areaR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &areaR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`listings`),
+		qm.WhereIn(`listings.area_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load listings")
+	}
+
+	var resultSlice []*Listing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice listings")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on listings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for listings")
+	}
+
+	if singular {
+		object.R.Listings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listingR{}
+			}
+			foreign.R.Area = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AreaID {
+				local.R.Listings = append(local.R.Listings, foreign)
+				if foreign.R == nil {
+					foreign.R = &listingR{}
+				}
+				foreign.R.Area = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetParent of the area to the related item.
+// Sets o.R.Parent to related.
+// Adds o to related.R.ParentAreas.
+func (o *Area) SetParent(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Area) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"areas\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"parent_id"}),
+		strmangle.WhereClause("\"", "\"", 2, areaPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ParentID, related.ID)
+	if o.R == nil {
+		o.R = &areaR{
+			Parent: related,
+		}
+	} else {
+		o.R.Parent = related
+	}
+
+	if related.R == nil {
+		related.R = &areaR{
+			ParentAreas: AreaSlice{o},
+		}
+	} else {
+		related.R.ParentAreas = append(related.R.ParentAreas, o)
+	}
+
+	return nil
+}
+
+// RemoveParent relationship.
+// Sets o.R.Parent to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Area) RemoveParent(ctx context.Context, exec boil.ContextExecutor, related *Area) error {
+	var err error
+
+	queries.SetScanner(&o.ParentID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("parent_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Parent = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ParentAreas {
+		if queries.Equal(o.ParentID, ri.ParentID) {
+			continue
+		}
+
+		ln := len(related.R.ParentAreas)
+		if ln > 1 && i < ln-1 {
+			related.R.ParentAreas[i] = related.R.ParentAreas[ln-1]
+		}
+		related.R.ParentAreas = related.R.ParentAreas[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddAreaGeometries adds the given related objects to the existing relationships
+// of the area, optionally inserting them as new records.
+// Appends related to o.R.AreaGeometries.
+// Sets related.R.Area appropriately.
+func (o *Area) AddAreaGeometries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AreaGeometry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AreaID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"area_geometries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"area_id"}),
+				strmangle.WhereClause("\"", "\"", 2, areaGeometryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AreaID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &areaR{
+			AreaGeometries: related,
+		}
+	} else {
+		o.R.AreaGeometries = append(o.R.AreaGeometries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &areaGeometryR{
+				Area: o,
+			}
+		} else {
+			rel.R.Area = o
+		}
+	}
+	return nil
+}
+
+// SetAreaGeometries removes all previously related items of the
+// area replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Area's AreaGeometries accordingly.
+// Replaces o.R.AreaGeometries with related.
+// Sets related.R.Area's AreaGeometries accordingly.
+func (o *Area) SetAreaGeometries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AreaGeometry) error {
+	query := "update \"area_geometries\" set \"area_id\" = null where \"area_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AreaGeometries {
+			queries.SetScanner(&rel.AreaID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Area = nil
+		}
+
+		o.R.AreaGeometries = nil
+	}
+	return o.AddAreaGeometries(ctx, exec, insert, related...)
+}
+
+// RemoveAreaGeometries relationships from objects passed in.
+// Removes related items from R.AreaGeometries (uses pointer comparison, removal does not keep order)
+// Sets related.R.Area.
+func (o *Area) RemoveAreaGeometries(ctx context.Context, exec boil.ContextExecutor, related ...*AreaGeometry) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AreaID, nil)
+		if rel.R != nil {
+			rel.R.Area = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("area_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AreaGeometries {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AreaGeometries)
+			if ln > 1 && i < ln-1 {
+				o.R.AreaGeometries[i] = o.R.AreaGeometries[ln-1]
+			}
+			o.R.AreaGeometries = o.R.AreaGeometries[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddParentAreas adds the given related objects to the existing relationships
+// of the area, optionally inserting them as new records.
+// Appends related to o.R.ParentAreas.
+// Sets related.R.Parent appropriately.
+func (o *Area) AddParentAreas(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Area) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ParentID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"areas\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"parent_id"}),
+				strmangle.WhereClause("\"", "\"", 2, areaPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ParentID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &areaR{
+			ParentAreas: related,
+		}
+	} else {
+		o.R.ParentAreas = append(o.R.ParentAreas, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &areaR{
+				Parent: o,
+			}
+		} else {
+			rel.R.Parent = o
+		}
+	}
+	return nil
+}
+
+// SetParentAreas removes all previously related items of the
+// area replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Parent's ParentAreas accordingly.
+// Replaces o.R.ParentAreas with related.
+// Sets related.R.Parent's ParentAreas accordingly.
+func (o *Area) SetParentAreas(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Area) error {
+	query := "update \"areas\" set \"parent_id\" = null where \"parent_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ParentAreas {
+			queries.SetScanner(&rel.ParentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Parent = nil
+		}
+
+		o.R.ParentAreas = nil
+	}
+	return o.AddParentAreas(ctx, exec, insert, related...)
+}
+
+// RemoveParentAreas relationships from objects passed in.
+// Removes related items from R.ParentAreas (uses pointer comparison, removal does not keep order)
+// Sets related.R.Parent.
+func (o *Area) RemoveParentAreas(ctx context.Context, exec boil.ContextExecutor, related ...*Area) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ParentID, nil)
+		if rel.R != nil {
+			rel.R.Parent = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("parent_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ParentAreas {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ParentAreas)
+			if ln > 1 && i < ln-1 {
+				o.R.ParentAreas[i] = o.R.ParentAreas[ln-1]
+			}
+			o.R.ParentAreas = o.R.ParentAreas[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddListingSearches adds the given related objects to the existing relationships
+// of the area, optionally inserting them as new records.
+// Appends related to o.R.ListingSearches.
+// Sets related.R.Area appropriately.
+func (o *Area) AddListingSearches(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListingSearch) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AreaID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"listing_searches\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"area_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listingSearchPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.AreaID, rel.ListingID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AreaID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &areaR{
+			ListingSearches: related,
+		}
+	} else {
+		o.R.ListingSearches = append(o.R.ListingSearches, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listingSearchR{
+				Area: o,
+			}
+		} else {
+			rel.R.Area = o
+		}
+	}
+	return nil
+}
+
 // AddListings adds the given related objects to the existing relationships
 // of the area, optionally inserting them as new records.
 // Appends related to o.R.Listings.
 // Sets related.R.Area appropriately.
-func (o *Area) AddListings(exec boil.Executor, insert bool, related ...*Listing) error {
+func (o *Area) AddListings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Listing) error {
 	var err error
 	for _, rel := range related {
 		if insert {
 			rel.AreaID = o.ID
-			if err = rel.Insert(exec, boil.Infer()); err != nil {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
@@ -358,11 +1235,12 @@ func (o *Area) AddListings(exec boil.Executor, insert bool, related ...*Listing)
 			)
 			values := []interface{}{o.ID, rel.ID}
 
-			if boil.DebugMode {
-				fmt.Fprintln(boil.DebugWriter, updateQuery)
-				fmt.Fprintln(boil.DebugWriter, values)
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
 			}
-			if _, err = exec.Exec(updateQuery, values...); err != nil {
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
@@ -398,7 +1276,7 @@ func Areas(mods ...qm.QueryMod) areaQuery {
 
 // FindArea retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
-func FindArea(exec boil.Executor, iD int, selectCols ...string) (*Area, error) {
+func FindArea(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*Area, error) {
 	areaObj := &Area{}
 
 	sel := "*"
@@ -411,7 +1289,7 @@ func FindArea(exec boil.Executor, iD int, selectCols ...string) (*Area, error) {
 
 	q := queries.Raw(query, iD)
 
-	err := q.Bind(nil, exec, areaObj)
+	err := q.Bind(ctx, exec, areaObj)
 	if err != nil {
 		if errors.Cause(err) == sql.ErrNoRows {
 			return nil, sql.ErrNoRows
@@ -424,7 +1302,7 @@ func FindArea(exec boil.Executor, iD int, selectCols ...string) (*Area, error) {
 
 // Insert a single record using an executor.
 // See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
-func (o *Area) Insert(exec boil.Executor, columns boil.Columns) error {
+func (o *Area) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
 	if o == nil {
 		return errors.New("models: no areas provided for insertion")
 	}
@@ -472,15 +1350,16 @@ func (o *Area) Insert(exec boil.Executor, columns boil.Columns) error {
 	value := reflect.Indirect(reflect.ValueOf(o))
 	vals := queries.ValuesFromMapping(value, cache.valueMapping)
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, cache.query)
-		fmt.Fprintln(boil.DebugWriter, vals)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
 	}
 
 	if len(cache.retMapping) != 0 {
-		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
 	} else {
-		_, err = exec.Exec(cache.query, vals...)
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
 	}
 
 	if err != nil {
@@ -499,7 +1378,7 @@ func (o *Area) Insert(exec boil.Executor, columns boil.Columns) error {
 // Update uses an executor to update the Area.
 // See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
 // Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
-func (o *Area) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+func (o *Area) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
 	var err error
 	key := makeCacheKey(columns, nil)
 	areaUpdateCacheMut.RLock()
@@ -531,12 +1410,13 @@ func (o *Area) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
 
 	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, cache.query)
-		fmt.Fprintln(boil.DebugWriter, values)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
 	}
 	var result sql.Result
-	result, err = exec.Exec(cache.query, values...)
+	result, err = exec.ExecContext(ctx, cache.query, values...)
 	if err != nil {
 		return 0, errors.Wrap(err, "models: unable to update areas row")
 	}
@@ -556,10 +1436,10 @@ func (o *Area) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
 }
 
 // UpdateAll updates all rows with the specified column values.
-func (q areaQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+func (q areaQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
 	queries.SetUpdate(q.Query, cols)
 
-	result, err := q.Query.Exec(exec)
+	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
 		return 0, errors.Wrap(err, "models: unable to update all for areas")
 	}
@@ -573,7 +1453,7 @@ func (q areaQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
 }
 
 // UpdateAll updates all rows with the specified column values, using an executor.
-func (o AreaSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+func (o AreaSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
 	ln := int64(len(o))
 	if ln == 0 {
 		return 0, nil
@@ -603,11 +1483,12 @@ func (o AreaSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
 		strmangle.SetParamNames("\"", "\"", 1, colNames),
 		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, areaPrimaryKeyColumns, len(o)))
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, sql)
-		fmt.Fprintln(boil.DebugWriter, args...)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
 	}
-	result, err := exec.Exec(sql, args...)
+	result, err := exec.ExecContext(ctx, sql, args...)
 	if err != nil {
 		return 0, errors.Wrap(err, "models: unable to update all in area slice")
 	}
@@ -621,7 +1502,7 @@ func (o AreaSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *Area) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+func (o *Area) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
 	if o == nil {
 		return errors.New("models: no areas provided for upsert")
 	}
@@ -704,17 +1585,18 @@ func (o *Area) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns
 		returns = queries.PtrsFromMapping(value, cache.retMapping)
 	}
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, cache.query)
-		fmt.Fprintln(boil.DebugWriter, vals)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
 	}
 	if len(cache.retMapping) != 0 {
-		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
 		if err == sql.ErrNoRows {
 			err = nil // Postgres doesn't return anything when there's no update
 		}
 	} else {
-		_, err = exec.Exec(cache.query, vals...)
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
 	}
 	if err != nil {
 		return errors.Wrap(err, "models: unable to upsert areas")
@@ -731,7 +1613,7 @@ func (o *Area) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns
 
 // Delete deletes a single Area record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *Area) Delete(exec boil.Executor) (int64, error) {
+func (o *Area) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
 	if o == nil {
 		return 0, errors.New("models: no Area provided for delete")
 	}
@@ -739,11 +1621,12 @@ func (o *Area) Delete(exec boil.Executor) (int64, error) {
 	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), areaPrimaryKeyMapping)
 	sql := "DELETE FROM \"areas\" WHERE \"id\"=$1"
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, sql)
-		fmt.Fprintln(boil.DebugWriter, args...)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
 	}
-	result, err := exec.Exec(sql, args...)
+	result, err := exec.ExecContext(ctx, sql, args...)
 	if err != nil {
 		return 0, errors.Wrap(err, "models: unable to delete from areas")
 	}
@@ -757,14 +1640,14 @@ func (o *Area) Delete(exec boil.Executor) (int64, error) {
 }
 
 // DeleteAll deletes all matching rows.
-func (q areaQuery) DeleteAll(exec boil.Executor) (int64, error) {
+func (q areaQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("models: no areaQuery provided for delete all")
 	}
 
 	queries.SetDelete(q.Query)
 
-	result, err := q.Query.Exec(exec)
+	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
 		return 0, errors.Wrap(err, "models: unable to delete all from areas")
 	}
@@ -778,7 +1661,7 @@ func (q areaQuery) DeleteAll(exec boil.Executor) (int64, error) {
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o AreaSlice) DeleteAll(exec boil.Executor) (int64, error) {
+func (o AreaSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
@@ -792,11 +1675,12 @@ func (o AreaSlice) DeleteAll(exec boil.Executor) (int64, error) {
 	sql := "DELETE FROM \"areas\" WHERE " +
 		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, areaPrimaryKeyColumns, len(o))
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, sql)
-		fmt.Fprintln(boil.DebugWriter, args)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
 	}
-	result, err := exec.Exec(sql, args...)
+	result, err := exec.ExecContext(ctx, sql, args...)
 	if err != nil {
 		return 0, errors.Wrap(err, "models: unable to delete all from area slice")
 	}
@@ -811,8 +1695,8 @@ func (o AreaSlice) DeleteAll(exec boil.Executor) (int64, error) {
 
 // Reload refetches the object from the database
 // using the primary keys with an executor.
-func (o *Area) Reload(exec boil.Executor) error {
-	ret, err := FindArea(exec, o.ID)
+func (o *Area) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindArea(ctx, exec, o.ID)
 	if err != nil {
 		return err
 	}
@@ -823,7 +1707,7 @@ func (o *Area) Reload(exec boil.Executor) error {
 
 // ReloadAll refetches every row with matching primary key column values
 // and overwrites the original object slice with the newly updated slice.
-func (o *AreaSlice) ReloadAll(exec boil.Executor) error {
+func (o *AreaSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
 	if o == nil || len(*o) == 0 {
 		return nil
 	}
@@ -840,7 +1724,7 @@ func (o *AreaSlice) ReloadAll(exec boil.Executor) error {
 
 	q := queries.Raw(sql, args...)
 
-	err := q.Bind(nil, exec, &slice)
+	err := q.Bind(ctx, exec, &slice)
 	if err != nil {
 		return errors.Wrap(err, "models: unable to reload all in AreaSlice")
 	}
@@ -851,15 +1735,16 @@ func (o *AreaSlice) ReloadAll(exec boil.Executor) error {
 }
 
 // AreaExists checks if the Area row exists.
-func AreaExists(exec boil.Executor, iD int) (bool, error) {
+func AreaExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
 	var exists bool
 	sql := "select exists(select 1 from \"areas\" where \"id\"=$1 limit 1)"
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, sql)
-		fmt.Fprintln(boil.DebugWriter, iD)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
 	}
-	row := exec.QueryRow(sql, iD)
+	row := exec.QueryRowContext(ctx, sql, iD)
 
 	err := row.Scan(&exists)
 	if err != nil {
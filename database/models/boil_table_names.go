@@ -4,9 +4,19 @@
 package models
 
 var TableNames = struct {
-	Areas    string
-	Listings string
+	AreaGeometries      string
+	Areas               string
+	Geofences           string
+	ListingPriceHistory string
+	ListingSearches     string
+	ListingSnapshots    string
+	Listings            string
 }{
-	Areas:    "areas",
-	Listings: "listings",
+	AreaGeometries:      "area_geometries",
+	Areas:               "areas",
+	Geofences:           "geofences",
+	ListingPriceHistory: "listing_price_history",
+	ListingSearches:     "listing_searches",
+	ListingSnapshots:    "listing_snapshots",
+	Listings:            "listings",
 }
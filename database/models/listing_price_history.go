@@ -0,0 +1,820 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ListingPriceHistory is an object representing the database table.
+type ListingPriceHistory struct {
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ListingID int       `boil:"listing_id" json:"listing_id" toml:"listing_id" yaml:"listing_id"`
+	Price     int       `boil:"price" json:"price" toml:"price" yaml:"price"`
+	SeenOn    time.Time `boil:"seen_on" json:"seen_on" toml:"seen_on" yaml:"seen_on"`
+
+	R *listingPriceHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L listingPriceHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ListingPriceHistoryColumns = struct {
+	ID        string
+	ListingID string
+	Price     string
+	SeenOn    string
+}{
+	ID:        "id",
+	ListingID: "listing_id",
+	Price:     "price",
+	SeenOn:    "seen_on",
+}
+
+// Generated where
+
+var ListingPriceHistoryWhere = struct {
+	ID        whereHelperint
+	ListingID whereHelperint
+	Price     whereHelperint
+	SeenOn    whereHelpertime_Time
+}{
+	ID:        whereHelperint{field: "\"listing_price_history\".\"id\""},
+	ListingID: whereHelperint{field: "\"listing_price_history\".\"listing_id\""},
+	Price:     whereHelperint{field: "\"listing_price_history\".\"price\""},
+	SeenOn:    whereHelpertime_Time{field: "\"listing_price_history\".\"seen_on\""},
+}
+
+// ListingPriceHistoryRels is where relationship names are stored.
+var ListingPriceHistoryRels = struct {
+	Listing string
+}{
+	Listing: "Listing",
+}
+
+// listingPriceHistoryR is where relationships are stored.
+type listingPriceHistoryR struct {
+	Listing *Listing `boil:"Listing" json:"Listing" toml:"Listing" yaml:"Listing"`
+}
+
+// NewStruct creates a new relationship struct
+func (*listingPriceHistoryR) NewStruct() *listingPriceHistoryR {
+	return &listingPriceHistoryR{}
+}
+
+// listingPriceHistoryL is where Load methods for each relationship are stored.
+type listingPriceHistoryL struct{}
+
+var (
+	listingPriceHistoryAllColumns            = []string{"id", "listing_id", "price", "seen_on"}
+	listingPriceHistoryColumnsWithoutDefault = []string{"listing_id", "price"}
+	listingPriceHistoryColumnsWithDefault    = []string{"id", "seen_on"}
+	listingPriceHistoryPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// ListingPriceHistorySlice is an alias for a slice of pointers to ListingPriceHistory.
+	// This should generally be used opposed to []ListingPriceHistory.
+	ListingPriceHistorySlice []*ListingPriceHistory
+
+	listingPriceHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	listingPriceHistoryType                 = reflect.TypeOf(&ListingPriceHistory{})
+	listingPriceHistoryMapping              = queries.MakeStructMapping(listingPriceHistoryType)
+	listingPriceHistoryPrimaryKeyMapping, _ = queries.BindMapping(listingPriceHistoryType, listingPriceHistoryMapping, listingPriceHistoryPrimaryKeyColumns)
+	listingPriceHistoryInsertCacheMut       sync.RWMutex
+	listingPriceHistoryInsertCache          = make(map[string]insertCache)
+	listingPriceHistoryUpdateCacheMut       sync.RWMutex
+	listingPriceHistoryUpdateCache          = make(map[string]updateCache)
+	listingPriceHistoryUpsertCacheMut       sync.RWMutex
+	listingPriceHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single listingPriceHistory record from the query.
+func (q listingPriceHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ListingPriceHistory, error) {
+	o := &ListingPriceHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for listing_price_history")
+	}
+
+	return o, nil
+}
+
+// All returns all ListingPriceHistory records from the query.
+func (q listingPriceHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (ListingPriceHistorySlice, error) {
+	var o []*ListingPriceHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ListingPriceHistory slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ListingPriceHistory records in the query.
+func (q listingPriceHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count listing_price_history rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q listingPriceHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if listing_price_history exists")
+	}
+
+	return count > 0, nil
+}
+
+// Listing pointed to by the foreign key.
+func (o *ListingPriceHistory) Listing(mods ...qm.QueryMod) listingQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ListingID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Listings(queryMods...)
+	queries.SetFrom(query.Query, "\"listings\"")
+
+	return query
+}
+
+// LoadListing allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listingPriceHistoryL) LoadListing(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListingPriceHistory interface{}, mods queries.Applicator) error {
+	var slice []*ListingPriceHistory
+	var object *ListingPriceHistory
+
+	if singular {
+		object = maybeListingPriceHistory.(*ListingPriceHistory)
+	} else {
+		slice = *maybeListingPriceHistory.(*[]*ListingPriceHistory)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listingPriceHistoryR{}
+		}
+		args = append(args, object.ListingID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listingPriceHistoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ListingID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ListingID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`listings`),
+		qm.WhereIn(`listings.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Listing")
+	}
+
+	var resultSlice []*Listing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Listing")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for listings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for listings")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Listing = foreign
+		if foreign.R == nil {
+			foreign.R = &listingR{}
+		}
+		foreign.R.ListingPriceHistories = append(foreign.R.ListingPriceHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ListingID == foreign.ID {
+				local.R.Listing = foreign
+				if foreign.R == nil {
+					foreign.R = &listingR{}
+				}
+				foreign.R.ListingPriceHistories = append(foreign.R.ListingPriceHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetListing of the listingPriceHistory to the related item.
+// Sets o.R.Listing to related.
+// Adds o to related.R.ListingPriceHistories.
+func (o *ListingPriceHistory) SetListing(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Listing) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"listing_price_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"listing_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listingPriceHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ListingID = related.ID
+	if o.R == nil {
+		o.R = &listingPriceHistoryR{
+			Listing: related,
+		}
+	} else {
+		o.R.Listing = related
+	}
+
+	if related.R == nil {
+		related.R = &listingR{
+			ListingPriceHistories: ListingPriceHistorySlice{o},
+		}
+	} else {
+		related.R.ListingPriceHistories = append(related.R.ListingPriceHistories, o)
+	}
+
+	return nil
+}
+
+// ListingPriceHistories retrieves all the records using an executor.
+func ListingPriceHistories(mods ...qm.QueryMod) listingPriceHistoryQuery {
+	mods = append(mods, qm.From("\"listing_price_history\""))
+	return listingPriceHistoryQuery{NewQuery(mods...)}
+}
+
+// FindListingPriceHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindListingPriceHistory(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ListingPriceHistory, error) {
+	listingPriceHistoryObj := &ListingPriceHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"listing_price_history\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, listingPriceHistoryObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from listing_price_history")
+	}
+
+	return listingPriceHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ListingPriceHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no listing_price_history provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(listingPriceHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	listingPriceHistoryInsertCacheMut.RLock()
+	cache, cached := listingPriceHistoryInsertCache[key]
+	listingPriceHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			listingPriceHistoryAllColumns,
+			listingPriceHistoryColumnsWithDefault,
+			listingPriceHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(listingPriceHistoryType, listingPriceHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(listingPriceHistoryType, listingPriceHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"listing_price_history\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"listing_price_history\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into listing_price_history")
+	}
+
+	if !cached {
+		listingPriceHistoryInsertCacheMut.Lock()
+		listingPriceHistoryInsertCache[key] = cache
+		listingPriceHistoryInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the ListingPriceHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ListingPriceHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	listingPriceHistoryUpdateCacheMut.RLock()
+	cache, cached := listingPriceHistoryUpdateCache[key]
+	listingPriceHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			listingPriceHistoryAllColumns,
+			listingPriceHistoryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update listing_price_history, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"listing_price_history\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, listingPriceHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(listingPriceHistoryType, listingPriceHistoryMapping, append(wl, listingPriceHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update listing_price_history row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for listing_price_history")
+	}
+
+	if !cached {
+		listingPriceHistoryUpdateCacheMut.Lock()
+		listingPriceHistoryUpdateCache[key] = cache
+		listingPriceHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q listingPriceHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for listing_price_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for listing_price_history")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ListingPriceHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listingPriceHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"listing_price_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, listingPriceHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in listingPriceHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all listingPriceHistory")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ListingPriceHistory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no listing_price_history provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listingPriceHistoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	listingPriceHistoryUpsertCacheMut.RLock()
+	cache, cached := listingPriceHistoryUpsertCache[key]
+	listingPriceHistoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			listingPriceHistoryAllColumns,
+			listingPriceHistoryColumnsWithDefault,
+			listingPriceHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			listingPriceHistoryAllColumns,
+			listingPriceHistoryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert listing_price_history, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(listingPriceHistoryPrimaryKeyColumns))
+			copy(conflict, listingPriceHistoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"listing_price_history\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(listingPriceHistoryType, listingPriceHistoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(listingPriceHistoryType, listingPriceHistoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert listing_price_history")
+	}
+
+	if !cached {
+		listingPriceHistoryUpsertCacheMut.Lock()
+		listingPriceHistoryUpsertCache[key] = cache
+		listingPriceHistoryUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single ListingPriceHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ListingPriceHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ListingPriceHistory provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), listingPriceHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"listing_price_history\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from listing_price_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for listing_price_history")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q listingPriceHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no listingPriceHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from listing_price_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for listing_price_history")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ListingPriceHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listingPriceHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"listing_price_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listingPriceHistoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from listingPriceHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for listing_price_history")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ListingPriceHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindListingPriceHistory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ListingPriceHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ListingPriceHistorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listingPriceHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"listing_price_history\".* FROM \"listing_price_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listingPriceHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ListingPriceHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ListingPriceHistoryExists checks if the ListingPriceHistory row exists.
+func ListingPriceHistoryExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"listing_price_history\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if listing_price_history exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,975 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ListingSearch is an object representing the database table.
+type ListingSearch struct {
+	AreaID     int       `boil:"area_id" json:"area_id" toml:"area_id" yaml:"area_id"`
+	ListingID  int       `boil:"listing_id" json:"listing_id" toml:"listing_id" yaml:"listing_id"`
+	LastSeenAt time.Time `boil:"last_seen_at" json:"last_seen_at" toml:"last_seen_at" yaml:"last_seen_at"`
+
+	R *listingSearchR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L listingSearchL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ListingSearchColumns = struct {
+	AreaID     string
+	ListingID  string
+	LastSeenAt string
+}{
+	AreaID:     "area_id",
+	ListingID:  "listing_id",
+	LastSeenAt: "last_seen_at",
+}
+
+// Generated where
+
+var ListingSearchWhere = struct {
+	AreaID     whereHelperint
+	ListingID  whereHelperint
+	LastSeenAt whereHelpertime_Time
+}{
+	AreaID:     whereHelperint{field: "\"listing_searches\".\"area_id\""},
+	ListingID:  whereHelperint{field: "\"listing_searches\".\"listing_id\""},
+	LastSeenAt: whereHelpertime_Time{field: "\"listing_searches\".\"last_seen_at\""},
+}
+
+// ListingSearchRels is where relationship names are stored.
+var ListingSearchRels = struct {
+	Area    string
+	Listing string
+}{
+	Area:    "Area",
+	Listing: "Listing",
+}
+
+// listingSearchR is where relationships are stored.
+type listingSearchR struct {
+	Area    *Area    `boil:"Area" json:"Area" toml:"Area" yaml:"Area"`
+	Listing *Listing `boil:"Listing" json:"Listing" toml:"Listing" yaml:"Listing"`
+}
+
+// NewStruct creates a new relationship struct
+func (*listingSearchR) NewStruct() *listingSearchR {
+	return &listingSearchR{}
+}
+
+// listingSearchL is where Load methods for each relationship are stored.
+type listingSearchL struct{}
+
+var (
+	listingSearchAllColumns            = []string{"area_id", "listing_id", "last_seen_at"}
+	listingSearchColumnsWithoutDefault = []string{"area_id", "listing_id"}
+	listingSearchColumnsWithDefault    = []string{"last_seen_at"}
+	listingSearchPrimaryKeyColumns     = []string{"area_id", "listing_id"}
+)
+
+type (
+	// ListingSearchSlice is an alias for a slice of pointers to ListingSearch.
+	// This should generally be used opposed to []ListingSearch.
+	ListingSearchSlice []*ListingSearch
+
+	listingSearchQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	listingSearchType                 = reflect.TypeOf(&ListingSearch{})
+	listingSearchMapping              = queries.MakeStructMapping(listingSearchType)
+	listingSearchPrimaryKeyMapping, _ = queries.BindMapping(listingSearchType, listingSearchMapping, listingSearchPrimaryKeyColumns)
+	listingSearchInsertCacheMut       sync.RWMutex
+	listingSearchInsertCache          = make(map[string]insertCache)
+	listingSearchUpdateCacheMut       sync.RWMutex
+	listingSearchUpdateCache          = make(map[string]updateCache)
+	listingSearchUpsertCacheMut       sync.RWMutex
+	listingSearchUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single listingSearch record from the query.
+func (q listingSearchQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ListingSearch, error) {
+	o := &ListingSearch{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for listing_searches")
+	}
+
+	return o, nil
+}
+
+// All returns all ListingSearch records from the query.
+func (q listingSearchQuery) All(ctx context.Context, exec boil.ContextExecutor) (ListingSearchSlice, error) {
+	var o []*ListingSearch
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ListingSearch slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ListingSearch records in the query.
+func (q listingSearchQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count listing_searches rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q listingSearchQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if listing_searches exists")
+	}
+
+	return count > 0, nil
+}
+
+// Area pointed to by the foreign key.
+func (o *ListingSearch) Area(mods ...qm.QueryMod) areaQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AreaID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Areas(queryMods...)
+	queries.SetFrom(query.Query, "\"areas\"")
+
+	return query
+}
+
+// Listing pointed to by the foreign key.
+func (o *ListingSearch) Listing(mods ...qm.QueryMod) listingQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ListingID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Listings(queryMods...)
+	queries.SetFrom(query.Query, "\"listings\"")
+
+	return query
+}
+
+// LoadArea allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listingSearchL) LoadArea(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListingSearch interface{}, mods queries.Applicator) error {
+	var slice []*ListingSearch
+	var object *ListingSearch
+
+	if singular {
+		object = maybeListingSearch.(*ListingSearch)
+	} else {
+		slice = *maybeListingSearch.(*[]*ListingSearch)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listingSearchR{}
+		}
+		args = append(args, object.AreaID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listingSearchR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AreaID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AreaID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`areas`),
+		qm.WhereIn(`areas.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Area")
+	}
+
+	var resultSlice []*Area
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Area")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for areas")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for areas")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Area = foreign
+		if foreign.R == nil {
+			foreign.R = &areaR{}
+		}
+		foreign.R.ListingSearches = append(foreign.R.ListingSearches, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AreaID == foreign.ID {
+				local.R.Area = foreign
+				if foreign.R == nil {
+					foreign.R = &areaR{}
+				}
+				foreign.R.ListingSearches = append(foreign.R.ListingSearches, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListing allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listingSearchL) LoadListing(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListingSearch interface{}, mods queries.Applicator) error {
+	var slice []*ListingSearch
+	var object *ListingSearch
+
+	if singular {
+		object = maybeListingSearch.(*ListingSearch)
+	} else {
+		slice = *maybeListingSearch.(*[]*ListingSearch)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listingSearchR{}
+		}
+		args = append(args, object.ListingID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listingSearchR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ListingID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ListingID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`listings`),
+		qm.WhereIn(`listings.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Listing")
+	}
+
+	var resultSlice []*Listing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Listing")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for listings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for listings")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Listing = foreign
+		if foreign.R == nil {
+			foreign.R = &listingR{}
+		}
+		foreign.R.ListingSearches = append(foreign.R.ListingSearches, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ListingID == foreign.ID {
+				local.R.Listing = foreign
+				if foreign.R == nil {
+					foreign.R = &listingR{}
+				}
+				foreign.R.ListingSearches = append(foreign.R.ListingSearches, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetArea of the listingSearch to the related item.
+// Sets o.R.Area to related.
+// Adds o to related.R.ListingSearches.
+func (o *ListingSearch) SetArea(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Area) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"listing_searches\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"area_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listingSearchPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.AreaID, o.ListingID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AreaID = related.ID
+	if o.R == nil {
+		o.R = &listingSearchR{
+			Area: related,
+		}
+	} else {
+		o.R.Area = related
+	}
+
+	if related.R == nil {
+		related.R = &areaR{
+			ListingSearches: ListingSearchSlice{o},
+		}
+	} else {
+		related.R.ListingSearches = append(related.R.ListingSearches, o)
+	}
+
+	return nil
+}
+
+// SetListing of the listingSearch to the related item.
+// Sets o.R.Listing to related.
+// Adds o to related.R.ListingSearches.
+func (o *ListingSearch) SetListing(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Listing) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"listing_searches\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"listing_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listingSearchPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.AreaID, o.ListingID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ListingID = related.ID
+	if o.R == nil {
+		o.R = &listingSearchR{
+			Listing: related,
+		}
+	} else {
+		o.R.Listing = related
+	}
+
+	if related.R == nil {
+		related.R = &listingR{
+			ListingSearches: ListingSearchSlice{o},
+		}
+	} else {
+		related.R.ListingSearches = append(related.R.ListingSearches, o)
+	}
+
+	return nil
+}
+
+// ListingSearches retrieves all the records using an executor.
+func ListingSearches(mods ...qm.QueryMod) listingSearchQuery {
+	mods = append(mods, qm.From("\"listing_searches\""))
+	return listingSearchQuery{NewQuery(mods...)}
+}
+
+// FindListingSearch retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindListingSearch(ctx context.Context, exec boil.ContextExecutor, areaID int, listingID int, selectCols ...string) (*ListingSearch, error) {
+	listingSearchObj := &ListingSearch{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"listing_searches\" where \"area_id\"=$1 AND \"listing_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, areaID, listingID)
+
+	err := q.Bind(ctx, exec, listingSearchObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from listing_searches")
+	}
+
+	return listingSearchObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ListingSearch) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no listing_searches provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(listingSearchColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	listingSearchInsertCacheMut.RLock()
+	cache, cached := listingSearchInsertCache[key]
+	listingSearchInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			listingSearchAllColumns,
+			listingSearchColumnsWithDefault,
+			listingSearchColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(listingSearchType, listingSearchMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(listingSearchType, listingSearchMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"listing_searches\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"listing_searches\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into listing_searches")
+	}
+
+	if !cached {
+		listingSearchInsertCacheMut.Lock()
+		listingSearchInsertCache[key] = cache
+		listingSearchInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the ListingSearch.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ListingSearch) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	listingSearchUpdateCacheMut.RLock()
+	cache, cached := listingSearchUpdateCache[key]
+	listingSearchUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			listingSearchAllColumns,
+			listingSearchPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update listing_searches, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"listing_searches\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, listingSearchPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(listingSearchType, listingSearchMapping, append(wl, listingSearchPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update listing_searches row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for listing_searches")
+	}
+
+	if !cached {
+		listingSearchUpdateCacheMut.Lock()
+		listingSearchUpdateCache[key] = cache
+		listingSearchUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q listingSearchQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for listing_searches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for listing_searches")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ListingSearchSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listingSearchPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"listing_searches\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, listingSearchPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in listingSearch slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all listingSearch")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ListingSearch) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no listing_searches provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listingSearchColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	listingSearchUpsertCacheMut.RLock()
+	cache, cached := listingSearchUpsertCache[key]
+	listingSearchUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			listingSearchAllColumns,
+			listingSearchColumnsWithDefault,
+			listingSearchColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			listingSearchAllColumns,
+			listingSearchPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert listing_searches, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(listingSearchPrimaryKeyColumns))
+			copy(conflict, listingSearchPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"listing_searches\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(listingSearchType, listingSearchMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(listingSearchType, listingSearchMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert listing_searches")
+	}
+
+	if !cached {
+		listingSearchUpsertCacheMut.Lock()
+		listingSearchUpsertCache[key] = cache
+		listingSearchUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single ListingSearch record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ListingSearch) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ListingSearch provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), listingSearchPrimaryKeyMapping)
+	sql := "DELETE FROM \"listing_searches\" WHERE \"area_id\"=$1 AND \"listing_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from listing_searches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for listing_searches")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q listingSearchQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no listingSearchQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from listing_searches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for listing_searches")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ListingSearchSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listingSearchPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"listing_searches\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listingSearchPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from listingSearch slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for listing_searches")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ListingSearch) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindListingSearch(ctx, exec, o.AreaID, o.ListingID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ListingSearchSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ListingSearchSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listingSearchPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"listing_searches\".* FROM \"listing_searches\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listingSearchPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ListingSearchSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ListingSearchExists checks if the ListingSearch row exists.
+func ListingSearchExists(ctx context.Context, exec boil.ContextExecutor, areaID int, listingID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"listing_searches\" where \"area_id\"=$1 AND \"listing_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, areaID, listingID)
+	}
+	row := exec.QueryRowContext(ctx, sql, areaID, listingID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if listing_searches exists")
+	}
+
+	return exists, nil
+}
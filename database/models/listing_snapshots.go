@@ -0,0 +1,863 @@
+// Code generated by SQLBoiler 4.2.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ListingSnapshot is an object representing the database table.
+type ListingSnapshot struct {
+	ID             int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt      null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	ListingID      int       `boil:"listing_id" json:"listing_id" toml:"listing_id" yaml:"listing_id"`
+	ListingData    null.JSON `boil:"listing_data" json:"listing_data,omitempty" toml:"listing_data" yaml:"listing_data,omitempty"`
+	ListingDetails null.JSON `boil:"listing_details" json:"listing_details,omitempty" toml:"listing_details" yaml:"listing_details,omitempty"`
+
+	R *listingSnapshotR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L listingSnapshotL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ListingSnapshotColumns = struct {
+	ID             string
+	CreatedAt      string
+	ListingID      string
+	ListingData    string
+	ListingDetails string
+}{
+	ID:             "id",
+	CreatedAt:      "created_at",
+	ListingID:      "listing_id",
+	ListingData:    "listing_data",
+	ListingDetails: "listing_details",
+}
+
+// Generated where
+
+type whereHelpernull_JSON struct{ field string }
+
+func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_JSON) NEQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_JSON) LT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_JSON) LTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_JSON) GT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var ListingSnapshotWhere = struct {
+	ID             whereHelperint
+	CreatedAt      whereHelpernull_Time
+	ListingID      whereHelperint
+	ListingData    whereHelpernull_JSON
+	ListingDetails whereHelpernull_JSON
+}{
+	ID:             whereHelperint{field: "\"listing_snapshots\".\"id\""},
+	CreatedAt:      whereHelpernull_Time{field: "\"listing_snapshots\".\"created_at\""},
+	ListingID:      whereHelperint{field: "\"listing_snapshots\".\"listing_id\""},
+	ListingData:    whereHelpernull_JSON{field: "\"listing_snapshots\".\"listing_data\""},
+	ListingDetails: whereHelpernull_JSON{field: "\"listing_snapshots\".\"listing_details\""},
+}
+
+// ListingSnapshotRels is where relationship names are stored.
+var ListingSnapshotRels = struct {
+	Listing string
+}{
+	Listing: "Listing",
+}
+
+// listingSnapshotR is where relationships are stored.
+type listingSnapshotR struct {
+	Listing *Listing `boil:"Listing" json:"Listing" toml:"Listing" yaml:"Listing"`
+}
+
+// NewStruct creates a new relationship struct
+func (*listingSnapshotR) NewStruct() *listingSnapshotR {
+	return &listingSnapshotR{}
+}
+
+// listingSnapshotL is where Load methods for each relationship are stored.
+type listingSnapshotL struct{}
+
+var (
+	listingSnapshotAllColumns            = []string{"id", "created_at", "listing_id", "listing_data", "listing_details"}
+	listingSnapshotColumnsWithoutDefault = []string{"listing_id", "listing_data", "listing_details"}
+	listingSnapshotColumnsWithDefault    = []string{"id", "created_at"}
+	listingSnapshotPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// ListingSnapshotSlice is an alias for a slice of pointers to ListingSnapshot.
+	// This should generally be used opposed to []ListingSnapshot.
+	ListingSnapshotSlice []*ListingSnapshot
+
+	listingSnapshotQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	listingSnapshotType                 = reflect.TypeOf(&ListingSnapshot{})
+	listingSnapshotMapping              = queries.MakeStructMapping(listingSnapshotType)
+	listingSnapshotPrimaryKeyMapping, _ = queries.BindMapping(listingSnapshotType, listingSnapshotMapping, listingSnapshotPrimaryKeyColumns)
+	listingSnapshotInsertCacheMut       sync.RWMutex
+	listingSnapshotInsertCache          = make(map[string]insertCache)
+	listingSnapshotUpdateCacheMut       sync.RWMutex
+	listingSnapshotUpdateCache          = make(map[string]updateCache)
+	listingSnapshotUpsertCacheMut       sync.RWMutex
+	listingSnapshotUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single listingSnapshot record from the query.
+func (q listingSnapshotQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ListingSnapshot, error) {
+	o := &ListingSnapshot{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for listing_snapshots")
+	}
+
+	return o, nil
+}
+
+// All returns all ListingSnapshot records from the query.
+func (q listingSnapshotQuery) All(ctx context.Context, exec boil.ContextExecutor) (ListingSnapshotSlice, error) {
+	var o []*ListingSnapshot
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ListingSnapshot slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ListingSnapshot records in the query.
+func (q listingSnapshotQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count listing_snapshots rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q listingSnapshotQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if listing_snapshots exists")
+	}
+
+	return count > 0, nil
+}
+
+// Listing pointed to by the foreign key.
+func (o *ListingSnapshot) Listing(mods ...qm.QueryMod) listingQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ListingID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Listings(queryMods...)
+	queries.SetFrom(query.Query, "\"listings\"")
+
+	return query
+}
+
+// LoadListing allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listingSnapshotL) LoadListing(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListingSnapshot interface{}, mods queries.Applicator) error {
+	var slice []*ListingSnapshot
+	var object *ListingSnapshot
+
+	if singular {
+		object = maybeListingSnapshot.(*ListingSnapshot)
+	} else {
+		slice = *maybeListingSnapshot.(*[]*ListingSnapshot)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listingSnapshotR{}
+		}
+		args = append(args, object.ListingID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listingSnapshotR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ListingID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ListingID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`listings`),
+		qm.WhereIn(`listings.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Listing")
+	}
+
+	var resultSlice []*Listing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Listing")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for listings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for listings")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Listing = foreign
+		if foreign.R == nil {
+			foreign.R = &listingR{}
+		}
+		foreign.R.ListingSnapshots = append(foreign.R.ListingSnapshots, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ListingID == foreign.ID {
+				local.R.Listing = foreign
+				if foreign.R == nil {
+					foreign.R = &listingR{}
+				}
+				foreign.R.ListingSnapshots = append(foreign.R.ListingSnapshots, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetListing of the listingSnapshot to the related item.
+// Sets o.R.Listing to related.
+// Adds o to related.R.ListingSnapshots.
+func (o *ListingSnapshot) SetListing(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Listing) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"listing_snapshots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"listing_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listingSnapshotPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ListingID = related.ID
+	if o.R == nil {
+		o.R = &listingSnapshotR{
+			Listing: related,
+		}
+	} else {
+		o.R.Listing = related
+	}
+
+	if related.R == nil {
+		related.R = &listingR{
+			ListingSnapshots: ListingSnapshotSlice{o},
+		}
+	} else {
+		related.R.ListingSnapshots = append(related.R.ListingSnapshots, o)
+	}
+
+	return nil
+}
+
+// ListingSnapshots retrieves all the records using an executor.
+func ListingSnapshots(mods ...qm.QueryMod) listingSnapshotQuery {
+	mods = append(mods, qm.From("\"listing_snapshots\""))
+	return listingSnapshotQuery{NewQuery(mods...)}
+}
+
+// FindListingSnapshot retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindListingSnapshot(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ListingSnapshot, error) {
+	listingSnapshotObj := &ListingSnapshot{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"listing_snapshots\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, listingSnapshotObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from listing_snapshots")
+	}
+
+	return listingSnapshotObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ListingSnapshot) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no listing_snapshots provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listingSnapshotColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	listingSnapshotInsertCacheMut.RLock()
+	cache, cached := listingSnapshotInsertCache[key]
+	listingSnapshotInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			listingSnapshotAllColumns,
+			listingSnapshotColumnsWithDefault,
+			listingSnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(listingSnapshotType, listingSnapshotMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(listingSnapshotType, listingSnapshotMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"listing_snapshots\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"listing_snapshots\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into listing_snapshots")
+	}
+
+	if !cached {
+		listingSnapshotInsertCacheMut.Lock()
+		listingSnapshotInsertCache[key] = cache
+		listingSnapshotInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the ListingSnapshot.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ListingSnapshot) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	listingSnapshotUpdateCacheMut.RLock()
+	cache, cached := listingSnapshotUpdateCache[key]
+	listingSnapshotUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			listingSnapshotAllColumns,
+			listingSnapshotPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update listing_snapshots, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"listing_snapshots\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, listingSnapshotPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(listingSnapshotType, listingSnapshotMapping, append(wl, listingSnapshotPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update listing_snapshots row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for listing_snapshots")
+	}
+
+	if !cached {
+		listingSnapshotUpdateCacheMut.Lock()
+		listingSnapshotUpdateCache[key] = cache
+		listingSnapshotUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q listingSnapshotQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for listing_snapshots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for listing_snapshots")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ListingSnapshotSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listingSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"listing_snapshots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, listingSnapshotPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in listingSnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all listingSnapshot")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ListingSnapshot) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no listing_snapshots provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(listingSnapshotColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	listingSnapshotUpsertCacheMut.RLock()
+	cache, cached := listingSnapshotUpsertCache[key]
+	listingSnapshotUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			listingSnapshotAllColumns,
+			listingSnapshotColumnsWithDefault,
+			listingSnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			listingSnapshotAllColumns,
+			listingSnapshotPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert listing_snapshots, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(listingSnapshotPrimaryKeyColumns))
+			copy(conflict, listingSnapshotPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"listing_snapshots\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(listingSnapshotType, listingSnapshotMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(listingSnapshotType, listingSnapshotMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert listing_snapshots")
+	}
+
+	if !cached {
+		listingSnapshotUpsertCacheMut.Lock()
+		listingSnapshotUpsertCache[key] = cache
+		listingSnapshotUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single ListingSnapshot record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ListingSnapshot) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ListingSnapshot provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), listingSnapshotPrimaryKeyMapping)
+	sql := "DELETE FROM \"listing_snapshots\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from listing_snapshots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for listing_snapshots")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q listingSnapshotQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no listingSnapshotQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from listing_snapshots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for listing_snapshots")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ListingSnapshotSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listingSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"listing_snapshots\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listingSnapshotPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from listingSnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for listing_snapshots")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ListingSnapshot) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindListingSnapshot(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ListingSnapshotSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ListingSnapshotSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), listingSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"listing_snapshots\".* FROM \"listing_snapshots\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listingSnapshotPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ListingSnapshotSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ListingSnapshotExists checks if the ListingSnapshot row exists.
+func ListingSnapshotExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"listing_snapshots\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if listing_snapshots exists")
+	}
+
+	return exists, nil
+}
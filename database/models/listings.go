@@ -4,6 +4,7 @@
 package models
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"reflect"
@@ -18,85 +19,134 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
-	"github.com/volatiletech/sqlboiler/v4/types/pgeo"
 	"github.com/volatiletech/strmangle"
+	"oikotie/geo"
 )
 
 // Listing is an object representing the database table.
 type Listing struct {
-	ID             int            `boil:"id" json:"id" toml:"id" yaml:"id"`
-	CreatedAt      null.Time      `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
-	ExternalID     int            `boil:"external_id" json:"external_id" toml:"external_id" yaml:"external_id"`
-	AreaID         int            `boil:"area_id" json:"area_id" toml:"area_id" yaml:"area_id"`
-	Price          int            `boil:"price" json:"price" toml:"price" yaml:"price"`
-	Size           float64        `boil:"size" json:"size" toml:"size" yaml:"size"`
-	Rooms          int            `boil:"rooms" json:"rooms" toml:"rooms" yaml:"rooms"`
-	Visits         int            `boil:"visits" json:"visits" toml:"visits" yaml:"visits"`
-	Floor          int            `boil:"floor" json:"floor" toml:"floor" yaml:"floor"`
-	ListingData    null.JSON      `boil:"listing_data" json:"listing_data,omitempty" toml:"listing_data" yaml:"listing_data,omitempty"`
-	ListingDetails null.JSON      `boil:"listing_details" json:"listing_details,omitempty" toml:"listing_details" yaml:"listing_details,omitempty"`
-	DateAccessed   time.Time      `boil:"date_accessed" json:"date_accessed" toml:"date_accessed" yaml:"date_accessed"`
-	Coord          pgeo.NullPoint `boil:"coord" json:"coord,omitempty" toml:"coord" yaml:"coord,omitempty"`
+	ID               int           `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatedAt        null.Time     `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	ExternalID       int           `boil:"external_id" json:"external_id" toml:"external_id" yaml:"external_id"`
+	AreaID           int           `boil:"area_id" json:"area_id" toml:"area_id" yaml:"area_id"`
+	Price            int           `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Size             float64       `boil:"size" json:"size" toml:"size" yaml:"size"`
+	Rooms            null.Int      `boil:"rooms" json:"rooms,omitempty" toml:"rooms" yaml:"rooms,omitempty"`
+	Visits           int           `boil:"visits" json:"visits" toml:"visits" yaml:"visits"`
+	Floor            null.Int      `boil:"floor" json:"floor,omitempty" toml:"floor" yaml:"floor,omitempty"`
+	ListingData      null.JSON     `boil:"listing_data" json:"listing_data,omitempty" toml:"listing_data" yaml:"listing_data,omitempty"`
+	ListingDetails   null.JSON     `boil:"listing_details" json:"listing_details,omitempty" toml:"listing_details" yaml:"listing_details,omitempty"`
+	DateAccessed     time.Time     `boil:"date_accessed" json:"date_accessed" toml:"date_accessed" yaml:"date_accessed"`
+	Coord            geo.NullPoint `boil:"coord" json:"coord,omitempty" toml:"coord" yaml:"coord,omitempty"`
+	RemovedAt        null.Time     `boil:"removed_at" json:"removed_at,omitempty" toml:"removed_at" yaml:"removed_at,omitempty"`
+	RemovedPrice     null.Int      `boil:"removed_price" json:"removed_price,omitempty" toml:"removed_price" yaml:"removed_price,omitempty"`
+	Kind             string        `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	MonthlyRent      null.Int      `boil:"monthly_rent" json:"monthly_rent,omitempty" toml:"monthly_rent" yaml:"monthly_rent,omitempty"`
+	Deposit          null.Int      `boil:"deposit" json:"deposit,omitempty" toml:"deposit" yaml:"deposit,omitempty"`
+	MinLeaseMonths   null.Int      `boil:"min_lease_months" json:"min_lease_months,omitempty" toml:"min_lease_months" yaml:"min_lease_months,omitempty"`
+	PropertyType     string        `boil:"property_type" json:"property_type" toml:"property_type" yaml:"property_type"`
+	LotSize          null.Float64  `boil:"lot_size" json:"lot_size,omitempty" toml:"lot_size" yaml:"lot_size,omitempty"`
+	TotalFloors      null.Int      `boil:"total_floors" json:"total_floors,omitempty" toml:"total_floors" yaml:"total_floors,omitempty"`
+	ConstructionYear null.Int      `boil:"construction_year" json:"construction_year,omitempty" toml:"construction_year" yaml:"construction_year,omitempty"`
+	MaintenanceFee   null.Float64  `boil:"maintenance_fee" json:"maintenance_fee,omitempty" toml:"maintenance_fee" yaml:"maintenance_fee,omitempty"`
+	FinancingFee     null.Float64  `boil:"financing_fee" json:"financing_fee,omitempty" toml:"financing_fee" yaml:"financing_fee,omitempty"`
+	DebtShare        null.Int      `boil:"debt_share" json:"debt_share,omitempty" toml:"debt_share" yaml:"debt_share,omitempty"`
+	DebtFreePrice    null.Int      `boil:"debt_free_price" json:"debt_free_price,omitempty" toml:"debt_free_price" yaml:"debt_free_price,omitempty"`
+	Condition        null.String   `boil:"condition" json:"condition,omitempty" toml:"condition" yaml:"condition,omitempty"`
+	EnergyClass      null.String   `boil:"energy_class" json:"energy_class,omitempty" toml:"energy_class" yaml:"energy_class,omitempty"`
+	HasElevator      null.Bool     `boil:"has_elevator" json:"has_elevator,omitempty" toml:"has_elevator" yaml:"has_elevator,omitempty"`
+	HasSauna         null.Bool     `boil:"has_sauna" json:"has_sauna,omitempty" toml:"has_sauna" yaml:"has_sauna,omitempty"`
+	HasBalcony       null.Bool     `boil:"has_balcony" json:"has_balcony,omitempty" toml:"has_balcony" yaml:"has_balcony,omitempty"`
+	LotOwnership     null.String   `boil:"lot_ownership" json:"lot_ownership,omitempty" toml:"lot_ownership" yaml:"lot_ownership,omitempty"`
+	LotRent          null.Float64  `boil:"lot_rent" json:"lot_rent,omitempty" toml:"lot_rent" yaml:"lot_rent,omitempty"`
+	ParseErrors      null.JSON     `boil:"parse_errors" json:"parse_errors,omitempty" toml:"parse_errors" yaml:"parse_errors,omitempty"`
+	ParserVersion    int           `boil:"parser_version" json:"parser_version" toml:"parser_version" yaml:"parser_version"`
+	GeofenceID       null.Int      `boil:"geofence_id" json:"geofence_id,omitempty" toml:"geofence_id" yaml:"geofence_id,omitempty"`
 
 	R *listingR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L listingL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ListingColumns = struct {
-	ID             string
-	CreatedAt      string
-	ExternalID     string
-	AreaID         string
-	Price          string
-	Size           string
-	Rooms          string
-	Visits         string
-	Floor          string
-	ListingData    string
-	ListingDetails string
-	DateAccessed   string
-	Coord          string
+	ID               string
+	CreatedAt        string
+	ExternalID       string
+	AreaID           string
+	Price            string
+	Size             string
+	Rooms            string
+	Visits           string
+	Floor            string
+	ListingData      string
+	ListingDetails   string
+	DateAccessed     string
+	Coord            string
+	RemovedAt        string
+	RemovedPrice     string
+	Kind             string
+	MonthlyRent      string
+	Deposit          string
+	MinLeaseMonths   string
+	PropertyType     string
+	LotSize          string
+	TotalFloors      string
+	ConstructionYear string
+	MaintenanceFee   string
+	FinancingFee     string
+	DebtShare        string
+	DebtFreePrice    string
+	Condition        string
+	EnergyClass      string
+	HasElevator      string
+	HasSauna         string
+	HasBalcony       string
+	LotOwnership     string
+	LotRent          string
+	ParseErrors      string
+	ParserVersion    string
+	GeofenceID       string
 }{
-	ID:             "id",
-	CreatedAt:      "created_at",
-	ExternalID:     "external_id",
-	AreaID:         "area_id",
-	Price:          "price",
-	Size:           "size",
-	Rooms:          "rooms",
-	Visits:         "visits",
-	Floor:          "floor",
-	ListingData:    "listing_data",
-	ListingDetails: "listing_details",
-	DateAccessed:   "date_accessed",
-	Coord:          "coord",
+	ID:               "id",
+	CreatedAt:        "created_at",
+	ExternalID:       "external_id",
+	AreaID:           "area_id",
+	Price:            "price",
+	Size:             "size",
+	Rooms:            "rooms",
+	Visits:           "visits",
+	Floor:            "floor",
+	ListingData:      "listing_data",
+	ListingDetails:   "listing_details",
+	DateAccessed:     "date_accessed",
+	Coord:            "coord",
+	RemovedAt:        "removed_at",
+	RemovedPrice:     "removed_price",
+	Kind:             "kind",
+	MonthlyRent:      "monthly_rent",
+	Deposit:          "deposit",
+	MinLeaseMonths:   "min_lease_months",
+	PropertyType:     "property_type",
+	LotSize:          "lot_size",
+	TotalFloors:      "total_floors",
+	ConstructionYear: "construction_year",
+	MaintenanceFee:   "maintenance_fee",
+	FinancingFee:     "financing_fee",
+	DebtShare:        "debt_share",
+	DebtFreePrice:    "debt_free_price",
+	Condition:        "condition",
+	EnergyClass:      "energy_class",
+	HasElevator:      "has_elevator",
+	HasSauna:         "has_sauna",
+	HasBalcony:       "has_balcony",
+	LotOwnership:     "lot_ownership",
+	LotRent:          "lot_rent",
+	ParseErrors:      "parse_errors",
+	ParserVersion:    "parser_version",
+	GeofenceID:       "geofence_id",
 }
 
 // Generated where
 
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 type whereHelperfloat64 struct{ field string }
 
 func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
@@ -126,113 +176,175 @@ func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-type whereHelpernull_JSON struct{ field string }
+type whereHelpergeo_NullPoint struct{ field string }
 
-func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
+func (w whereHelpergeo_NullPoint) EQ(x geo.NullPoint) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpernull_JSON) NEQ(x null.JSON) qm.QueryMod {
+func (w whereHelpergeo_NullPoint) NEQ(x geo.NullPoint) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_JSON) LT(x null.JSON) qm.QueryMod {
+func (w whereHelpergeo_NullPoint) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpergeo_NullPoint) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpergeo_NullPoint) LT(x geo.NullPoint) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpernull_JSON) LTE(x null.JSON) qm.QueryMod {
+func (w whereHelpergeo_NullPoint) LTE(x geo.NullPoint) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpernull_JSON) GT(x null.JSON) qm.QueryMod {
+func (w whereHelpergeo_NullPoint) GT(x geo.NullPoint) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
+func (w whereHelpergeo_NullPoint) GTE(x geo.NullPoint) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
-type whereHelpertime_Time struct{ field string }
+type whereHelpernull_Float64 struct{ field string }
 
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
+func (w whereHelpernull_Float64) EQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+func (w whereHelpernull_Float64) NEQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+func (w whereHelpernull_Float64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Float64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Float64) LT(x null.Float64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+func (w whereHelpernull_Float64) LTE(x null.Float64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+func (w whereHelpernull_Float64) GT(x null.Float64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+func (w whereHelpernull_Float64) GTE(x null.Float64) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
-type whereHelperpgeo_NullPoint struct{ field string }
+type whereHelpernull_Bool struct{ field string }
 
-func (w whereHelperpgeo_NullPoint) EQ(x pgeo.NullPoint) qm.QueryMod {
+func (w whereHelpernull_Bool) EQ(x null.Bool) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelperpgeo_NullPoint) NEQ(x pgeo.NullPoint) qm.QueryMod {
+func (w whereHelpernull_Bool) NEQ(x null.Bool) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelperpgeo_NullPoint) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelperpgeo_NullPoint) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelperpgeo_NullPoint) LT(x pgeo.NullPoint) qm.QueryMod {
+func (w whereHelpernull_Bool) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Bool) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Bool) LT(x null.Bool) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelperpgeo_NullPoint) LTE(x pgeo.NullPoint) qm.QueryMod {
+func (w whereHelpernull_Bool) LTE(x null.Bool) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelperpgeo_NullPoint) GT(x pgeo.NullPoint) qm.QueryMod {
+func (w whereHelpernull_Bool) GT(x null.Bool) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelperpgeo_NullPoint) GTE(x pgeo.NullPoint) qm.QueryMod {
+func (w whereHelpernull_Bool) GTE(x null.Bool) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
 var ListingWhere = struct {
-	ID             whereHelperint
-	CreatedAt      whereHelpernull_Time
-	ExternalID     whereHelperint
-	AreaID         whereHelperint
-	Price          whereHelperint
-	Size           whereHelperfloat64
-	Rooms          whereHelperint
-	Visits         whereHelperint
-	Floor          whereHelperint
-	ListingData    whereHelpernull_JSON
-	ListingDetails whereHelpernull_JSON
-	DateAccessed   whereHelpertime_Time
-	Coord          whereHelperpgeo_NullPoint
+	ID               whereHelperint
+	CreatedAt        whereHelpernull_Time
+	ExternalID       whereHelperint
+	AreaID           whereHelperint
+	Price            whereHelperint
+	Size             whereHelperfloat64
+	Rooms            whereHelpernull_Int
+	Visits           whereHelperint
+	Floor            whereHelpernull_Int
+	ListingData      whereHelpernull_JSON
+	ListingDetails   whereHelpernull_JSON
+	DateAccessed     whereHelpertime_Time
+	Coord            whereHelpergeo_NullPoint
+	RemovedAt        whereHelpernull_Time
+	RemovedPrice     whereHelpernull_Int
+	Kind             whereHelperstring
+	MonthlyRent      whereHelpernull_Int
+	Deposit          whereHelpernull_Int
+	MinLeaseMonths   whereHelpernull_Int
+	PropertyType     whereHelperstring
+	LotSize          whereHelpernull_Float64
+	TotalFloors      whereHelpernull_Int
+	ConstructionYear whereHelpernull_Int
+	MaintenanceFee   whereHelpernull_Float64
+	FinancingFee     whereHelpernull_Float64
+	DebtShare        whereHelpernull_Int
+	DebtFreePrice    whereHelpernull_Int
+	Condition        whereHelpernull_String
+	EnergyClass      whereHelpernull_String
+	HasElevator      whereHelpernull_Bool
+	HasSauna         whereHelpernull_Bool
+	HasBalcony       whereHelpernull_Bool
+	LotOwnership     whereHelpernull_String
+	LotRent          whereHelpernull_Float64
+	ParseErrors      whereHelpernull_JSON
+	ParserVersion    whereHelperint
+	GeofenceID       whereHelpernull_Int
 }{
-	ID:             whereHelperint{field: "\"listings\".\"id\""},
-	CreatedAt:      whereHelpernull_Time{field: "\"listings\".\"created_at\""},
-	ExternalID:     whereHelperint{field: "\"listings\".\"external_id\""},
-	AreaID:         whereHelperint{field: "\"listings\".\"area_id\""},
-	Price:          whereHelperint{field: "\"listings\".\"price\""},
-	Size:           whereHelperfloat64{field: "\"listings\".\"size\""},
-	Rooms:          whereHelperint{field: "\"listings\".\"rooms\""},
-	Visits:         whereHelperint{field: "\"listings\".\"visits\""},
-	Floor:          whereHelperint{field: "\"listings\".\"floor\""},
-	ListingData:    whereHelpernull_JSON{field: "\"listings\".\"listing_data\""},
-	ListingDetails: whereHelpernull_JSON{field: "\"listings\".\"listing_details\""},
-	DateAccessed:   whereHelpertime_Time{field: "\"listings\".\"date_accessed\""},
-	Coord:          whereHelperpgeo_NullPoint{field: "\"listings\".\"coord\""},
+	ID:               whereHelperint{field: "\"listings\".\"id\""},
+	CreatedAt:        whereHelpernull_Time{field: "\"listings\".\"created_at\""},
+	ExternalID:       whereHelperint{field: "\"listings\".\"external_id\""},
+	AreaID:           whereHelperint{field: "\"listings\".\"area_id\""},
+	Price:            whereHelperint{field: "\"listings\".\"price\""},
+	Size:             whereHelperfloat64{field: "\"listings\".\"size\""},
+	Rooms:            whereHelpernull_Int{field: "\"listings\".\"rooms\""},
+	Visits:           whereHelperint{field: "\"listings\".\"visits\""},
+	Floor:            whereHelpernull_Int{field: "\"listings\".\"floor\""},
+	ListingData:      whereHelpernull_JSON{field: "\"listings\".\"listing_data\""},
+	ListingDetails:   whereHelpernull_JSON{field: "\"listings\".\"listing_details\""},
+	DateAccessed:     whereHelpertime_Time{field: "\"listings\".\"date_accessed\""},
+	Coord:            whereHelpergeo_NullPoint{field: "\"listings\".\"coord\""},
+	RemovedAt:        whereHelpernull_Time{field: "\"listings\".\"removed_at\""},
+	RemovedPrice:     whereHelpernull_Int{field: "\"listings\".\"removed_price\""},
+	Kind:             whereHelperstring{field: "\"listings\".\"kind\""},
+	MonthlyRent:      whereHelpernull_Int{field: "\"listings\".\"monthly_rent\""},
+	Deposit:          whereHelpernull_Int{field: "\"listings\".\"deposit\""},
+	MinLeaseMonths:   whereHelpernull_Int{field: "\"listings\".\"min_lease_months\""},
+	PropertyType:     whereHelperstring{field: "\"listings\".\"property_type\""},
+	LotSize:          whereHelpernull_Float64{field: "\"listings\".\"lot_size\""},
+	TotalFloors:      whereHelpernull_Int{field: "\"listings\".\"total_floors\""},
+	ConstructionYear: whereHelpernull_Int{field: "\"listings\".\"construction_year\""},
+	MaintenanceFee:   whereHelpernull_Float64{field: "\"listings\".\"maintenance_fee\""},
+	FinancingFee:     whereHelpernull_Float64{field: "\"listings\".\"financing_fee\""},
+	DebtShare:        whereHelpernull_Int{field: "\"listings\".\"debt_share\""},
+	DebtFreePrice:    whereHelpernull_Int{field: "\"listings\".\"debt_free_price\""},
+	Condition:        whereHelpernull_String{field: "\"listings\".\"condition\""},
+	EnergyClass:      whereHelpernull_String{field: "\"listings\".\"energy_class\""},
+	HasElevator:      whereHelpernull_Bool{field: "\"listings\".\"has_elevator\""},
+	HasSauna:         whereHelpernull_Bool{field: "\"listings\".\"has_sauna\""},
+	HasBalcony:       whereHelpernull_Bool{field: "\"listings\".\"has_balcony\""},
+	LotOwnership:     whereHelpernull_String{field: "\"listings\".\"lot_ownership\""},
+	LotRent:          whereHelpernull_Float64{field: "\"listings\".\"lot_rent\""},
+	ParseErrors:      whereHelpernull_JSON{field: "\"listings\".\"parse_errors\""},
+	ParserVersion:    whereHelperint{field: "\"listings\".\"parser_version\""},
+	GeofenceID:       whereHelpernull_Int{field: "\"listings\".\"geofence_id\""},
 }
 
 // ListingRels is where relationship names are stored.
 var ListingRels = struct {
-	Area string
+	Area                  string
+	Geofence              string
+	ListingPriceHistories string
+	ListingSearches       string
+	ListingSnapshots      string
 }{
-	Area: "Area",
+	Area:                  "Area",
+	Geofence:              "Geofence",
+	ListingPriceHistories: "ListingPriceHistories",
+	ListingSearches:       "ListingSearches",
+	ListingSnapshots:      "ListingSnapshots",
 }
 
 // listingR is where relationships are stored.
 type listingR struct {
-	Area *Area `boil:"Area" json:"Area" toml:"Area" yaml:"Area"`
+	Area                  *Area                    `boil:"Area" json:"Area" toml:"Area" yaml:"Area"`
+	Geofence              *Geofence                `boil:"Geofence" json:"Geofence" toml:"Geofence" yaml:"Geofence"`
+	ListingPriceHistories ListingPriceHistorySlice `boil:"ListingPriceHistories" json:"ListingPriceHistories" toml:"ListingPriceHistories" yaml:"ListingPriceHistories"`
+	ListingSearches       ListingSearchSlice       `boil:"ListingSearches" json:"ListingSearches" toml:"ListingSearches" yaml:"ListingSearches"`
+	ListingSnapshots      ListingSnapshotSlice     `boil:"ListingSnapshots" json:"ListingSnapshots" toml:"ListingSnapshots" yaml:"ListingSnapshots"`
 }
 
 // NewStruct creates a new relationship struct
@@ -244,9 +356,9 @@ func (*listingR) NewStruct() *listingR {
 type listingL struct{}
 
 var (
-	listingAllColumns            = []string{"id", "created_at", "external_id", "area_id", "price", "size", "rooms", "visits", "floor", "listing_data", "listing_details", "date_accessed", "coord"}
-	listingColumnsWithoutDefault = []string{"external_id", "area_id", "price", "size", "rooms", "visits", "floor", "listing_data", "listing_details", "coord"}
-	listingColumnsWithDefault    = []string{"id", "created_at", "date_accessed"}
+	listingAllColumns            = []string{"id", "created_at", "external_id", "area_id", "price", "size", "rooms", "visits", "floor", "listing_data", "listing_details", "date_accessed", "coord", "removed_at", "removed_price", "kind", "monthly_rent", "deposit", "min_lease_months", "property_type", "lot_size", "total_floors", "construction_year", "maintenance_fee", "financing_fee", "debt_share", "debt_free_price", "condition", "energy_class", "has_elevator", "has_sauna", "has_balcony", "lot_ownership", "lot_rent", "parse_errors", "parser_version", "geofence_id"}
+	listingColumnsWithoutDefault = []string{"external_id", "area_id", "price", "size", "rooms", "visits", "floor", "listing_data", "listing_details", "coord", "removed_at", "removed_price", "monthly_rent", "deposit", "min_lease_months", "lot_size", "total_floors", "construction_year", "maintenance_fee", "financing_fee", "debt_share", "debt_free_price", "condition", "energy_class", "has_elevator", "has_sauna", "has_balcony", "lot_ownership", "lot_rent", "parse_errors", "geofence_id"}
+	listingColumnsWithDefault    = []string{"id", "created_at", "date_accessed", "kind", "property_type", "parser_version"}
 	listingPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -282,12 +394,12 @@ var (
 )
 
 // One returns a single listing record from the query.
-func (q listingQuery) One(exec boil.Executor) (*Listing, error) {
+func (q listingQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Listing, error) {
 	o := &Listing{}
 
 	queries.SetLimit(q.Query, 1)
 
-	err := q.Bind(nil, exec, o)
+	err := q.Bind(ctx, exec, o)
 	if err != nil {
 		if errors.Cause(err) == sql.ErrNoRows {
 			return nil, sql.ErrNoRows
@@ -299,10 +411,10 @@ func (q listingQuery) One(exec boil.Executor) (*Listing, error) {
 }
 
 // All returns all Listing records from the query.
-func (q listingQuery) All(exec boil.Executor) (ListingSlice, error) {
+func (q listingQuery) All(ctx context.Context, exec boil.ContextExecutor) (ListingSlice, error) {
 	var o []*Listing
 
-	err := q.Bind(nil, exec, &o)
+	err := q.Bind(ctx, exec, &o)
 	if err != nil {
 		return nil, errors.Wrap(err, "models: failed to assign all query results to Listing slice")
 	}
@@ -311,13 +423,13 @@ func (q listingQuery) All(exec boil.Executor) (ListingSlice, error) {
 }
 
 // Count returns the count of all Listing records in the query.
-func (q listingQuery) Count(exec boil.Executor) (int64, error) {
+func (q listingQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
 	var count int64
 
 	queries.SetSelect(q.Query, nil)
 	queries.SetCount(q.Query)
 
-	err := q.Query.QueryRow(exec).Scan(&count)
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
 	if err != nil {
 		return 0, errors.Wrap(err, "models: failed to count listings rows")
 	}
@@ -326,14 +438,14 @@ func (q listingQuery) Count(exec boil.Executor) (int64, error) {
 }
 
 // Exists checks if the row exists in the table.
-func (q listingQuery) Exists(exec boil.Executor) (bool, error) {
+func (q listingQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
 	var count int64
 
 	queries.SetSelect(q.Query, nil)
 	queries.SetCount(q.Query)
 	queries.SetLimit(q.Query, 1)
 
-	err := q.Query.QueryRow(exec).Scan(&count)
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
 	if err != nil {
 		return false, errors.Wrap(err, "models: failed to check if listings exists")
 	}
@@ -355,9 +467,86 @@ func (o *Listing) Area(mods ...qm.QueryMod) areaQuery {
 	return query
 }
 
+// Geofence pointed to by the foreign key.
+func (o *Listing) Geofence(mods ...qm.QueryMod) geofenceQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.GeofenceID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Geofences(queryMods...)
+	queries.SetFrom(query.Query, "\"geofences\"")
+
+	return query
+}
+
+// ListingPriceHistories retrieves all the listing_price_history's ListingPriceHistories with an executor.
+func (o *Listing) ListingPriceHistories(mods ...qm.QueryMod) listingPriceHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"listing_price_history\".\"listing_id\"=?", o.ID),
+	)
+
+	query := ListingPriceHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"listing_price_history\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"listing_price_history\".*"})
+	}
+
+	return query
+}
+
+// ListingSearches retrieves all the listing_search's ListingSearches with an executor.
+func (o *Listing) ListingSearches(mods ...qm.QueryMod) listingSearchQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"listing_searches\".\"listing_id\"=?", o.ID),
+	)
+
+	query := ListingSearches(queryMods...)
+	queries.SetFrom(query.Query, "\"listing_searches\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"listing_searches\".*"})
+	}
+
+	return query
+}
+
+// ListingSnapshots retrieves all the listing_snapshot's ListingSnapshots with an executor.
+func (o *Listing) ListingSnapshots(mods ...qm.QueryMod) listingSnapshotQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"listing_snapshots\".\"listing_id\"=?", o.ID),
+	)
+
+	query := ListingSnapshots(queryMods...)
+	queries.SetFrom(query.Query, "\"listing_snapshots\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"listing_snapshots\".*"})
+	}
+
+	return query
+}
+
 // LoadArea allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (listingL) LoadArea(e boil.Executor, singular bool, maybeListing interface{}, mods queries.Applicator) error {
+func (listingL) LoadArea(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListing interface{}, mods queries.Applicator) error {
 	var slice []*Listing
 	var object *Listing
 
@@ -404,7 +593,7 @@ func (listingL) LoadArea(e boil.Executor, singular bool, maybeListing interface{
 		mods.Apply(query)
 	}
 
-	results, err := query.Query(e)
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
 		return errors.Wrap(err, "failed to eager load Area")
 	}
@@ -451,13 +640,386 @@ func (listingL) LoadArea(e boil.Executor, singular bool, maybeListing interface{
 	return nil
 }
 
+// LoadGeofence allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (listingL) LoadGeofence(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListing interface{}, mods queries.Applicator) error {
+	var slice []*Listing
+	var object *Listing
+
+	if singular {
+		object = maybeListing.(*Listing)
+	} else {
+		slice = *maybeListing.(*[]*Listing)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listingR{}
+		}
+		if !queries.IsNil(object.GeofenceID) {
+			args = append(args, object.GeofenceID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listingR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.GeofenceID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.GeofenceID) {
+				args = append(args, obj.GeofenceID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`geofences`),
+		qm.WhereIn(`geofences.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Geofence")
+	}
+
+	var resultSlice []*Geofence
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Geofence")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for geofences")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for geofences")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Geofence = foreign
+		if foreign.R == nil {
+			foreign.R = &geofenceR{}
+		}
+		foreign.R.Listings = append(foreign.R.Listings, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.GeofenceID, foreign.ID) {
+				local.R.Geofence = foreign
+				if foreign.R == nil {
+					foreign.R = &geofenceR{}
+				}
+				foreign.R.Listings = append(foreign.R.Listings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListingPriceHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (listingL) LoadListingPriceHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListing interface{}, mods queries.Applicator) error {
+	var slice []*Listing
+	var object *Listing
+
+	if singular {
+		object = maybeListing.(*Listing)
+	} else {
+		slice = *maybeListing.(*[]*Listing)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listingR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listingR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`listing_price_history`),
+		qm.WhereIn(`listing_price_history.listing_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load listing_price_history")
+	}
+
+	var resultSlice []*ListingPriceHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice listing_price_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on listing_price_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for listing_price_history")
+	}
+
+	if singular {
+		object.R.ListingPriceHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listingPriceHistoryR{}
+			}
+			foreign.R.Listing = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ListingID {
+				local.R.ListingPriceHistories = append(local.R.ListingPriceHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &listingPriceHistoryR{}
+				}
+				foreign.R.Listing = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListingSearches allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (listingL) LoadListingSearches(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListing interface{}, mods queries.Applicator) error {
+	var slice []*Listing
+	var object *Listing
+
+	if singular {
+		object = maybeListing.(*Listing)
+	} else {
+		slice = *maybeListing.(*[]*Listing)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listingR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listingR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`listing_searches`),
+		qm.WhereIn(`listing_searches.listing_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load listing_searches")
+	}
+
+	var resultSlice []*ListingSearch
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice listing_searches")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on listing_searches")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for listing_searches")
+	}
+
+	if singular {
+		object.R.ListingSearches = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listingSearchR{}
+			}
+			foreign.R.Listing = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ListingID {
+				local.R.ListingSearches = append(local.R.ListingSearches, foreign)
+				if foreign.R == nil {
+					foreign.R = &listingSearchR{}
+				}
+				foreign.R.Listing = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadListingSnapshots allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (listingL) LoadListingSnapshots(ctx context.Context, e boil.ContextExecutor, singular bool, maybeListing interface{}, mods queries.Applicator) error {
+	var slice []*Listing
+	var object *Listing
+
+	if singular {
+		object = maybeListing.(*Listing)
+	} else {
+		slice = *maybeListing.(*[]*Listing)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &listingR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &listingR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`listing_snapshots`),
+		qm.WhereIn(`listing_snapshots.listing_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load listing_snapshots")
+	}
+
+	var resultSlice []*ListingSnapshot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice listing_snapshots")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on listing_snapshots")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for listing_snapshots")
+	}
+
+	if singular {
+		object.R.ListingSnapshots = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listingSnapshotR{}
+			}
+			foreign.R.Listing = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ListingID {
+				local.R.ListingSnapshots = append(local.R.ListingSnapshots, foreign)
+				if foreign.R == nil {
+					foreign.R = &listingSnapshotR{}
+				}
+				foreign.R.Listing = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetArea of the listing to the related item.
 // Sets o.R.Area to related.
 // Adds o to related.R.Listings.
-func (o *Listing) SetArea(exec boil.Executor, insert bool, related *Area) error {
+func (o *Listing) SetArea(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Area) error {
 	var err error
 	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
 			return errors.Wrap(err, "failed to insert into foreign table")
 		}
 	}
@@ -469,11 +1031,12 @@ func (o *Listing) SetArea(exec boil.Executor, insert bool, related *Area) error
 	)
 	values := []interface{}{related.ID, o.ID}
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
 	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
 		return errors.Wrap(err, "failed to update local table")
 	}
 
@@ -497,6 +1060,245 @@ func (o *Listing) SetArea(exec boil.Executor, insert bool, related *Area) error
 	return nil
 }
 
+// SetGeofence of the listing to the related item.
+// Sets o.R.Geofence to related.
+// Adds o to related.R.Listings.
+func (o *Listing) SetGeofence(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Geofence) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"listings\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"geofence_id"}),
+		strmangle.WhereClause("\"", "\"", 2, listingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.GeofenceID, related.ID)
+	if o.R == nil {
+		o.R = &listingR{
+			Geofence: related,
+		}
+	} else {
+		o.R.Geofence = related
+	}
+
+	if related.R == nil {
+		related.R = &geofenceR{
+			Listings: ListingSlice{o},
+		}
+	} else {
+		related.R.Listings = append(related.R.Listings, o)
+	}
+
+	return nil
+}
+
+// RemoveGeofence relationship.
+// Sets o.R.Geofence to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Listing) RemoveGeofence(ctx context.Context, exec boil.ContextExecutor, related *Geofence) error {
+	var err error
+
+	queries.SetScanner(&o.GeofenceID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("geofence_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Geofence = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Listings {
+		if queries.Equal(o.GeofenceID, ri.GeofenceID) {
+			continue
+		}
+
+		ln := len(related.R.Listings)
+		if ln > 1 && i < ln-1 {
+			related.R.Listings[i] = related.R.Listings[ln-1]
+		}
+		related.R.Listings = related.R.Listings[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddListingPriceHistories adds the given related objects to the existing relationships
+// of the listing, optionally inserting them as new records.
+// Appends related to o.R.ListingPriceHistories.
+// Sets related.R.Listing appropriately.
+func (o *Listing) AddListingPriceHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListingPriceHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ListingID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"listing_price_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"listing_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listingPriceHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ListingID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &listingR{
+			ListingPriceHistories: related,
+		}
+	} else {
+		o.R.ListingPriceHistories = append(o.R.ListingPriceHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listingPriceHistoryR{
+				Listing: o,
+			}
+		} else {
+			rel.R.Listing = o
+		}
+	}
+	return nil
+}
+
+// AddListingSearches adds the given related objects to the existing relationships
+// of the listing, optionally inserting them as new records.
+// Appends related to o.R.ListingSearches.
+// Sets related.R.Listing appropriately.
+func (o *Listing) AddListingSearches(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListingSearch) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ListingID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"listing_searches\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"listing_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listingSearchPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.AreaID, rel.ListingID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ListingID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &listingR{
+			ListingSearches: related,
+		}
+	} else {
+		o.R.ListingSearches = append(o.R.ListingSearches, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listingSearchR{
+				Listing: o,
+			}
+		} else {
+			rel.R.Listing = o
+		}
+	}
+	return nil
+}
+
+// AddListingSnapshots adds the given related objects to the existing relationships
+// of the listing, optionally inserting them as new records.
+// Appends related to o.R.ListingSnapshots.
+// Sets related.R.Listing appropriately.
+func (o *Listing) AddListingSnapshots(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ListingSnapshot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ListingID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"listing_snapshots\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"listing_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listingSnapshotPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ListingID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &listingR{
+			ListingSnapshots: related,
+		}
+	} else {
+		o.R.ListingSnapshots = append(o.R.ListingSnapshots, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listingSnapshotR{
+				Listing: o,
+			}
+		} else {
+			rel.R.Listing = o
+		}
+	}
+	return nil
+}
+
 // Listings retrieves all the records using an executor.
 func Listings(mods ...qm.QueryMod) listingQuery {
 	mods = append(mods, qm.From("\"listings\""))
@@ -505,7 +1307,7 @@ func Listings(mods ...qm.QueryMod) listingQuery {
 
 // FindListing retrieves a single record by ID with an executor.
 // If selectCols is empty Find will return all columns.
-func FindListing(exec boil.Executor, iD int, selectCols ...string) (*Listing, error) {
+func FindListing(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*Listing, error) {
 	listingObj := &Listing{}
 
 	sel := "*"
@@ -518,7 +1320,7 @@ func FindListing(exec boil.Executor, iD int, selectCols ...string) (*Listing, er
 
 	q := queries.Raw(query, iD)
 
-	err := q.Bind(nil, exec, listingObj)
+	err := q.Bind(ctx, exec, listingObj)
 	if err != nil {
 		if errors.Cause(err) == sql.ErrNoRows {
 			return nil, sql.ErrNoRows
@@ -531,16 +1333,18 @@ func FindListing(exec boil.Executor, iD int, selectCols ...string) (*Listing, er
 
 // Insert a single record using an executor.
 // See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
-func (o *Listing) Insert(exec boil.Executor, columns boil.Columns) error {
+func (o *Listing) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
 	if o == nil {
 		return errors.New("models: no listings provided for insertion")
 	}
 
 	var err error
-	currTime := time.Now().In(boil.GetLocation())
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
 
-	if queries.MustTime(o.CreatedAt).IsZero() {
-		queries.SetScanner(&o.CreatedAt, currTime)
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
 	}
 
 	nzDefaults := queries.NonZeroDefaultSet(listingColumnsWithDefault, o)
@@ -584,15 +1388,16 @@ func (o *Listing) Insert(exec boil.Executor, columns boil.Columns) error {
 	value := reflect.Indirect(reflect.ValueOf(o))
 	vals := queries.ValuesFromMapping(value, cache.valueMapping)
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, cache.query)
-		fmt.Fprintln(boil.DebugWriter, vals)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
 	}
 
 	if len(cache.retMapping) != 0 {
-		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
 	} else {
-		_, err = exec.Exec(cache.query, vals...)
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
 	}
 
 	if err != nil {
@@ -611,7 +1416,7 @@ func (o *Listing) Insert(exec boil.Executor, columns boil.Columns) error {
 // Update uses an executor to update the Listing.
 // See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
 // Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
-func (o *Listing) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+func (o *Listing) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
 	var err error
 	key := makeCacheKey(columns, nil)
 	listingUpdateCacheMut.RLock()
@@ -643,12 +1448,13 @@ func (o *Listing) Update(exec boil.Executor, columns boil.Columns) (int64, error
 
 	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, cache.query)
-		fmt.Fprintln(boil.DebugWriter, values)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
 	}
 	var result sql.Result
-	result, err = exec.Exec(cache.query, values...)
+	result, err = exec.ExecContext(ctx, cache.query, values...)
 	if err != nil {
 		return 0, errors.Wrap(err, "models: unable to update listings row")
 	}
@@ -668,10 +1474,10 @@ func (o *Listing) Update(exec boil.Executor, columns boil.Columns) (int64, error
 }
 
 // UpdateAll updates all rows with the specified column values.
-func (q listingQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+func (q listingQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
 	queries.SetUpdate(q.Query, cols)
 
-	result, err := q.Query.Exec(exec)
+	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
 		return 0, errors.Wrap(err, "models: unable to update all for listings")
 	}
@@ -685,7 +1491,7 @@ func (q listingQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
 }
 
 // UpdateAll updates all rows with the specified column values, using an executor.
-func (o ListingSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+func (o ListingSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
 	ln := int64(len(o))
 	if ln == 0 {
 		return 0, nil
@@ -715,11 +1521,12 @@ func (o ListingSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
 		strmangle.SetParamNames("\"", "\"", 1, colNames),
 		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, listingPrimaryKeyColumns, len(o)))
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, sql)
-		fmt.Fprintln(boil.DebugWriter, args...)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
 	}
-	result, err := exec.Exec(sql, args...)
+	result, err := exec.ExecContext(ctx, sql, args...)
 	if err != nil {
 		return 0, errors.Wrap(err, "models: unable to update all in listing slice")
 	}
@@ -733,14 +1540,16 @@ func (o ListingSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *Listing) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+func (o *Listing) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
 	if o == nil {
 		return errors.New("models: no listings provided for upsert")
 	}
-	currTime := time.Now().In(boil.GetLocation())
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
 
-	if queries.MustTime(o.CreatedAt).IsZero() {
-		queries.SetScanner(&o.CreatedAt, currTime)
+		if queries.MustTime(o.CreatedAt).IsZero() {
+			queries.SetScanner(&o.CreatedAt, currTime)
+		}
 	}
 
 	nzDefaults := queries.NonZeroDefaultSet(listingColumnsWithDefault, o)
@@ -821,17 +1630,18 @@ func (o *Listing) Upsert(exec boil.Executor, updateOnConflict bool, conflictColu
 		returns = queries.PtrsFromMapping(value, cache.retMapping)
 	}
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, cache.query)
-		fmt.Fprintln(boil.DebugWriter, vals)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
 	}
 	if len(cache.retMapping) != 0 {
-		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
 		if err == sql.ErrNoRows {
 			err = nil // Postgres doesn't return anything when there's no update
 		}
 	} else {
-		_, err = exec.Exec(cache.query, vals...)
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
 	}
 	if err != nil {
 		return errors.Wrap(err, "models: unable to upsert listings")
@@ -848,7 +1658,7 @@ func (o *Listing) Upsert(exec boil.Executor, updateOnConflict bool, conflictColu
 
 // Delete deletes a single Listing record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *Listing) Delete(exec boil.Executor) (int64, error) {
+func (o *Listing) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
 	if o == nil {
 		return 0, errors.New("models: no Listing provided for delete")
 	}
@@ -856,11 +1666,12 @@ func (o *Listing) Delete(exec boil.Executor) (int64, error) {
 	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), listingPrimaryKeyMapping)
 	sql := "DELETE FROM \"listings\" WHERE \"id\"=$1"
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, sql)
-		fmt.Fprintln(boil.DebugWriter, args...)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
 	}
-	result, err := exec.Exec(sql, args...)
+	result, err := exec.ExecContext(ctx, sql, args...)
 	if err != nil {
 		return 0, errors.Wrap(err, "models: unable to delete from listings")
 	}
@@ -874,14 +1685,14 @@ func (o *Listing) Delete(exec boil.Executor) (int64, error) {
 }
 
 // DeleteAll deletes all matching rows.
-func (q listingQuery) DeleteAll(exec boil.Executor) (int64, error) {
+func (q listingQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("models: no listingQuery provided for delete all")
 	}
 
 	queries.SetDelete(q.Query)
 
-	result, err := q.Query.Exec(exec)
+	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
 		return 0, errors.Wrap(err, "models: unable to delete all from listings")
 	}
@@ -895,7 +1706,7 @@ func (q listingQuery) DeleteAll(exec boil.Executor) (int64, error) {
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o ListingSlice) DeleteAll(exec boil.Executor) (int64, error) {
+func (o ListingSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
@@ -909,11 +1720,12 @@ func (o ListingSlice) DeleteAll(exec boil.Executor) (int64, error) {
 	sql := "DELETE FROM \"listings\" WHERE " +
 		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, listingPrimaryKeyColumns, len(o))
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, sql)
-		fmt.Fprintln(boil.DebugWriter, args)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
 	}
-	result, err := exec.Exec(sql, args...)
+	result, err := exec.ExecContext(ctx, sql, args...)
 	if err != nil {
 		return 0, errors.Wrap(err, "models: unable to delete all from listing slice")
 	}
@@ -928,8 +1740,8 @@ func (o ListingSlice) DeleteAll(exec boil.Executor) (int64, error) {
 
 // Reload refetches the object from the database
 // using the primary keys with an executor.
-func (o *Listing) Reload(exec boil.Executor) error {
-	ret, err := FindListing(exec, o.ID)
+func (o *Listing) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindListing(ctx, exec, o.ID)
 	if err != nil {
 		return err
 	}
@@ -940,7 +1752,7 @@ func (o *Listing) Reload(exec boil.Executor) error {
 
 // ReloadAll refetches every row with matching primary key column values
 // and overwrites the original object slice with the newly updated slice.
-func (o *ListingSlice) ReloadAll(exec boil.Executor) error {
+func (o *ListingSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
 	if o == nil || len(*o) == 0 {
 		return nil
 	}
@@ -957,7 +1769,7 @@ func (o *ListingSlice) ReloadAll(exec boil.Executor) error {
 
 	q := queries.Raw(sql, args...)
 
-	err := q.Bind(nil, exec, &slice)
+	err := q.Bind(ctx, exec, &slice)
 	if err != nil {
 		return errors.Wrap(err, "models: unable to reload all in ListingSlice")
 	}
@@ -968,15 +1780,16 @@ func (o *ListingSlice) ReloadAll(exec boil.Executor) error {
 }
 
 // ListingExists checks if the Listing row exists.
-func ListingExists(exec boil.Executor, iD int) (bool, error) {
+func ListingExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
 	var exists bool
 	sql := "select exists(select 1 from \"listings\" where \"id\"=$1 limit 1)"
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, sql)
-		fmt.Fprintln(boil.DebugWriter, iD)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
 	}
-	row := exec.QueryRow(sql, iD)
+	row := exec.QueryRowContext(ctx, sql, iD)
 
 	err := row.Scan(&exists)
 	if err != nil {
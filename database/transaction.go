@@ -6,8 +6,8 @@ import (
 	"fmt"
 )
 
-func Do(db *sql.DB, f func(*sql.Tx) error) error {
-	ctx := context.TODO()
+// Do runs f in a transaction, which is rolled back if f fails or ctx is cancelled
+func Do(ctx context.Context, db *sql.DB, f func(*sql.Tx) error) error {
 	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
@@ -22,7 +22,7 @@ func Do(db *sql.DB, f func(*sql.Tx) error) error {
 
 	err = f(tx)
 	if err != nil {
-		if txErr := tx.Rollback(); txErr != nil {
+		if txErr := tx.Rollback(); txErr != nil && txErr != sql.ErrTxDone {
 			return fmt.Errorf("Underlying: %v, %w", err, txErr)
 		}
 		return err
@@ -0,0 +1,224 @@
+package filter
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// BuildingType is a building type known to Oikotie
+type BuildingType string
+
+const (
+	ApartmentBlock    BuildingType = "apartmentBlock"    // Kerrostalo
+	RowHouse          BuildingType = "rowHouse"          // Rivitalo
+	DetachedHouse     BuildingType = "detachedHouse"     // Omakotitalo
+	SemiDetachedHouse BuildingType = "semiDetachedHouse" // Paritalo
+)
+
+var buildingTypeCodes = map[BuildingType][]int{
+	ApartmentBlock:    {1, 256},
+	RowHouse:          {2},
+	DetachedHouse:     {4},
+	SemiDetachedHouse: {8},
+}
+
+// Condition is a condition class of a listing
+type Condition string
+
+const (
+	Excellent    Condition = "excellent"    // Erinomainen
+	Good         Condition = "good"         // Hyvä
+	Satisfactory Condition = "satisfactory" // Tyydyttävä
+	Passable     Condition = "passable"     // Välttävä
+	Poor         Condition = "poor"         // Huono
+)
+
+var conditionCodes = map[Condition]int{
+	Excellent:    1,
+	Good:         2,
+	Satisfactory: 4,
+	Passable:     8,
+	Poor:         16,
+}
+
+// LotOwnership is the ownership type of the lot a building is on
+type LotOwnership string
+
+const (
+	OwnLot          LotOwnership = "own"          // Oma
+	RentedLot       LotOwnership = "rented"       // Vuokralla
+	OptionalRentLot LotOwnership = "optionalRent" // Valinnainen vuokratontti
+)
+
+var lotOwnershipCodes = map[LotOwnership]int{
+	OwnLot:          1,
+	RentedLot:       2,
+	OptionalRentLot: 3,
+}
+
+// Newness limits the search to new or used listings, empty means both
+type Newness string
+
+const (
+	AnyNewness Newness = ""
+	New        Newness = "new"
+	Used       Newness = "used"
+)
+
+// SortOrder is the order Oikotie returns cards in
+type SortOrder string
+
+const (
+	PublishedDesc SortOrder = "published_sort_desc"
+	PublishedAsc  SortOrder = "published_sort_asc"
+	PriceAsc      SortOrder = "price_asc"
+	PriceDesc     SortOrder = "price_desc"
+	SizeAsc       SortOrder = "size_asc"
+	SizeDesc      SortOrder = "size_desc"
+)
+
+var sortOrders = map[SortOrder]struct{}{
+	PublishedDesc: {},
+	PublishedAsc:  {},
+	PriceAsc:      {},
+	PriceDesc:     {},
+	SizeAsc:       {},
+	SizeDesc:      {},
+}
+
+// maxRooms is Oikotie's largest room count filter, meaning that many or more
+const maxRooms = 5
+
+type Range struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+// Filters are the Oikotie search filters besides location, price and size
+type Filters struct {
+	// BuildingTypes defaults to apartment blocks when empty
+	BuildingTypes    []BuildingType `json:"buildingTypes"`
+	Conditions       []Condition    `json:"conditions"`
+	LotOwnership     []LotOwnership `json:"lotOwnership"`
+	Rooms            []int          `json:"rooms"`
+	ConstructionYear *Range         `json:"constructionYear"`
+	Newness          Newness        `json:"newness"`
+	// SortBy defaults to the newest first
+	SortBy SortOrder `json:"sortBy"`
+}
+
+// Validate checks that every value is one Oikotie recognizes
+func (f Filters) Validate() error {
+	for _, t := range f.BuildingTypes {
+		if _, ok := buildingTypeCodes[t]; !ok {
+			return fmt.Errorf("buildingTypes: unknown value %q", t)
+		}
+	}
+
+	for _, c := range f.Conditions {
+		if _, ok := conditionCodes[c]; !ok {
+			return fmt.Errorf("conditions: unknown value %q", c)
+		}
+	}
+
+	for _, o := range f.LotOwnership {
+		if _, ok := lotOwnershipCodes[o]; !ok {
+			return fmt.Errorf("lotOwnership: unknown value %q", o)
+		}
+	}
+
+	for _, r := range f.Rooms {
+		if r < 1 || r > maxRooms {
+			return fmt.Errorf("rooms: %d not between 1 and %d", r, maxRooms)
+		}
+	}
+
+	if y := f.ConstructionYear; y != nil && y.Max != 0 && y.Min > y.Max {
+		return fmt.Errorf("constructionYear: min %d is after max %d", y.Min, y.Max)
+	}
+
+	switch f.Newness {
+	case AnyNewness, New, Used:
+	default:
+		return fmt.Errorf("newness: unknown value %q", f.Newness)
+	}
+
+	if f.SortBy != "" {
+		if _, ok := sortOrders[f.SortBy]; !ok {
+			return fmt.Errorf("sortBy: unknown value %q", f.SortBy)
+		}
+	}
+
+	return nil
+}
+
+// Buildings returns the building types searched, defaulting to apartment blocks
+func (f Filters) Buildings() []BuildingType {
+	if len(f.BuildingTypes) == 0 {
+		return []BuildingType{ApartmentBlock}
+	}
+	return f.BuildingTypes
+}
+
+// Unscoped returns the names of the filters set that stored listings can't be
+// matched against: newness isn't stored and rooms aren't known for every listing
+func (f Filters) Unscoped() []string {
+	var names []string
+	if len(f.Rooms) > 0 {
+		names = append(names, "rooms")
+	}
+	if f.Newness != AnyNewness {
+		names = append(names, "newness")
+	}
+	return names
+}
+
+// Sort returns the sort order, defaulting to the newest first
+func (f Filters) Sort() SortOrder {
+	if f.SortBy == "" {
+		return PublishedDesc
+	}
+	return f.SortBy
+}
+
+// Apply adds the filters to the query of a cards request for listings of kind
+func (f Filters) Apply(q url.Values, kind Kind) {
+	if kind.HasBuildings() {
+		for _, t := range f.Buildings() {
+			for _, code := range buildingTypeCodes[t] {
+				q.Add("buildingType[]", strconv.Itoa(code))
+			}
+		}
+	}
+
+	for _, c := range f.Conditions {
+		q.Add("conditionType[]", strconv.Itoa(conditionCodes[c]))
+	}
+
+	for _, o := range f.LotOwnership {
+		q.Add("lotOwnershipType[]", strconv.Itoa(lotOwnershipCodes[o]))
+	}
+
+	for _, r := range f.Rooms {
+		q.Add("roomCount[]", strconv.Itoa(r))
+	}
+
+	if y := f.ConstructionYear; y != nil {
+		if y.Min != 0 {
+			q.Add("constructionYear[min]", strconv.Itoa(y.Min))
+		}
+		if y.Max != 0 {
+			q.Add("constructionYear[max]", strconv.Itoa(y.Max))
+		}
+	}
+
+	switch f.Newness {
+	case New:
+		q.Add("newDevelopment", "1")
+	case Used:
+		q.Add("newDevelopment", "0")
+	}
+
+	q.Add("sortBy", string(f.Sort()))
+}
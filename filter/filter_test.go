@@ -0,0 +1,173 @@
+package filter
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		wantErr string
+	}{
+		{"empty", `{}`, ""},
+		{"every filter", `{
+			"buildingTypes": ["apartmentBlock", "rowHouse", "detachedHouse", "semiDetachedHouse"],
+			"conditions": ["excellent", "good", "satisfactory", "passable", "poor"],
+			"lotOwnership": ["own", "rented", "optionalRent"],
+			"rooms": [1, 5],
+			"constructionYear": {"min": 1950, "max": 2020},
+			"newness": "used",
+			"sortBy": "price_asc"
+		}`, ""},
+		{"construction year without max", `{"constructionYear": {"min": 1950}}`, ""},
+		{"construction year of one year", `{"constructionYear": {"min": 1950, "max": 1950}}`, ""},
+		{"unknown building type", `{"buildingTypes": ["apartmentBlock", "castle"]}`, `buildingTypes: unknown value "castle"`},
+		{"building type in Finnish", `{"buildingTypes": ["kerrostalo"]}`, `buildingTypes: unknown value "kerrostalo"`},
+		{"unknown condition", `{"conditions": ["Good"]}`, `conditions: unknown value "Good"`},
+		{"unknown lot ownership", `{"lotOwnership": ["optional"]}`, `lotOwnership: unknown value "optional"`},
+		{"no rooms", `{"rooms": [0]}`, "rooms: 0 not between 1 and 5"},
+		{"too many rooms", `{"rooms": [2, 6]}`, "rooms: 6 not between 1 and 5"},
+		{"construction year min after max", `{"constructionYear": {"min": 2020, "max": 1950}}`, "constructionYear: min 2020 is after max 1950"},
+		{"unknown newness", `{"newness": "old"}`, `newness: unknown value "old"`},
+		{"unknown sort order", `{"sortBy": "published"}`, `sortBy: unknown value "published"`},
+	}
+
+	for _, tt := range tests {
+		var f Filters
+		err := json.Unmarshal([]byte(tt.json), &f)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+
+		err = f.Validate()
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != tt.wantErr {
+			t.Errorf("%s: Validate() = %q, want %q", tt.name, got, tt.wantErr)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters Filters
+		kind    Kind
+		want    url.Values
+	}{
+		{
+			name: "defaults",
+			kind: Sale,
+			want: url.Values{
+				"buildingType[]": {"1", "256"},
+				"sortBy":         {"published_sort_desc"},
+			},
+		},
+		{
+			name: "every filter",
+			filters: Filters{
+				BuildingTypes:    []BuildingType{RowHouse, SemiDetachedHouse},
+				Conditions:       []Condition{Excellent, Poor},
+				LotOwnership:     []LotOwnership{OwnLot, OptionalRentLot},
+				Rooms:            []int{1, 5},
+				ConstructionYear: &Range{Min: 1950, Max: 2020},
+				Newness:          New,
+				SortBy:           SizeDesc,
+			},
+			kind: Sale,
+			want: url.Values{
+				"buildingType[]":        {"2", "8"},
+				"conditionType[]":       {"1", "16"},
+				"lotOwnershipType[]":    {"1", "3"},
+				"roomCount[]":           {"1", "5"},
+				"constructionYear[min]": {"1950"},
+				"constructionYear[max]": {"2020"},
+				"newDevelopment":        {"1"},
+				"sortBy":                {"size_desc"},
+			},
+		},
+		{
+			name:    "open construction year range",
+			filters: Filters{ConstructionYear: &Range{Max: 1960}, Newness: Used},
+			kind:    Rent,
+			want: url.Values{
+				"buildingType[]":        {"1", "256"},
+				"constructionYear[max]": {"1960"},
+				"newDevelopment":        {"0"},
+				"sortBy":                {"published_sort_desc"},
+			},
+		},
+		{
+			// Plots have no buildings, the building types are left out
+			name:    "plots",
+			filters: Filters{BuildingTypes: []BuildingType{DetachedHouse}},
+			kind:    Plot,
+			want: url.Values{
+				"sortBy": {"published_sort_desc"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		q := url.Values{}
+		tt.filters.Apply(q, tt.kind)
+		if !reflect.DeepEqual(q, tt.want) {
+			t.Errorf("%s: Apply() = %v, want %v", tt.name, q, tt.want)
+		}
+	}
+}
+
+func TestUnscoped(t *testing.T) {
+	tests := []struct {
+		filters Filters
+		want    []string
+	}{
+		{Filters{}, nil},
+		{Filters{BuildingTypes: []BuildingType{RowHouse}, SortBy: PriceAsc}, nil},
+		{Filters{Conditions: []Condition{Good}, LotOwnership: []LotOwnership{OwnLot}, ConstructionYear: &Range{Min: 1950}}, nil},
+		{Filters{Rooms: []int{2}}, []string{"rooms"}},
+		{Filters{Newness: Used}, []string{"newness"}},
+		{Filters{Rooms: []int{2}, Newness: New, Conditions: []Condition{Good}}, []string{"rooms", "newness"}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.filters.Unscoped(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%+v.Unscoped() = %v, want %v", tt.filters, got, tt.want)
+		}
+	}
+}
+
+func TestKind(t *testing.T) {
+	tests := []struct {
+		kind     Kind
+		valid    bool
+		cardType int
+		path     string
+	}{
+		{"", true, 100, "myytavat-asunnot"},
+		{Sale, true, 100, "myytavat-asunnot"},
+		{Rent, true, 101, "vuokra-asunnot"},
+		{Plot, true, 104, "myytavat-tontit"},
+		{"Sale", false, 0, ""},
+		{"lease", false, 0, ""},
+	}
+
+	for _, tt := range tests {
+		err := tt.kind.Validate()
+		if (err == nil) != tt.valid {
+			t.Errorf("Kind(%q).Validate() = %v, want valid %v", tt.kind, err, tt.valid)
+		}
+		if !tt.valid {
+			continue
+		}
+		if tt.kind.CardType() != tt.cardType || tt.kind.Path() != tt.path {
+			t.Errorf("Kind(%q) card type %d, path %q, want %d, %q", tt.kind, tt.kind.CardType(), tt.kind.Path(), tt.cardType, tt.path)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package filter
+
+import "fmt"
+
+// Kind is the section of Oikotie listings are scraped from: homes for sale or
+// for rent, or plots for sale
+type Kind string
+
+const (
+	Sale Kind = "sale" // Myytävät asunnot
+	Rent Kind = "rent" // Vuokra-asunnot
+	Plot Kind = "plot" // Myytävät tontit
+)
+
+type kindInfo struct {
+	cardType int
+	path     string
+}
+
+var kinds = map[Kind]kindInfo{
+	Sale: {cardType: 100, path: "myytavat-asunnot"},
+	Rent: {cardType: 101, path: "vuokra-asunnot"},
+	Plot: {cardType: 104, path: "myytavat-tontit"},
+}
+
+// Validate checks that the kind is known, an empty kind means Sale
+func (k Kind) Validate() error {
+	if _, ok := kinds[k.OrDefault()]; !ok {
+		return fmt.Errorf("kind: unknown value %q", k)
+	}
+	return nil
+}
+
+// OrDefault returns Sale for an empty kind
+func (k Kind) OrDefault() Kind {
+	if k == "" {
+		return Sale
+	}
+	return k
+}
+
+// HasBuildings reports whether the building type filter applies to the kind
+func (k Kind) HasBuildings() bool {
+	return k.OrDefault() != Plot
+}
+
+// CardType is the cardType query parameter of the cards API
+func (k Kind) CardType() int {
+	return kinds[k.OrDefault()].cardType
+}
+
+// Path is the first path segment of the kind's pages on Oikotie
+func (k Kind) Path() string {
+	return kinds[k.OrDefault()].path
+}
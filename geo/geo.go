@@ -0,0 +1,53 @@
+// Package geo reads area boundaries from GeoJSON and shapefiles, stores them
+// in PostGIS and places listings in the areas whose boundaries contain them
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// WGS84 is the SRID of latitude and longitude, which listing coordinates
+// and stored boundaries are in
+const WGS84 = 4326
+
+// Feature is a boundary with its attributes. The geometry is a GeoJSON
+// Polygon or MultiPolygon
+type Feature struct {
+	Properties map[string]string
+	Geometry   json.RawMessage
+}
+
+// Layer is the features of a file along with the SRID their coordinates are
+// in, 0 when the file doesn't tell
+type Layer struct {
+	Features []Feature
+	SRID     int
+}
+
+// Read reads a GeoJSON file or an ESRI shapefile, given by its .shp file
+func Read(path string) (Layer, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json", ".geojson":
+		return readGeoJSON(path)
+	case ".shp":
+		return readShapefile(path)
+	}
+
+	return Layer{}, fmt.Errorf("%s is neither GeoJSON (.json, .geojson) nor a shapefile (.shp)", path)
+}
+
+// Property returns the first of the properties the feature has
+func (f Feature) Property(names ...string) (string, bool) {
+	for _, name := range names {
+		for key, value := range f.Properties {
+			if strings.EqualFold(key, name) {
+				return strings.TrimSpace(value), true
+			}
+		}
+	}
+
+	return "", false
+}
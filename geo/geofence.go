@@ -0,0 +1,111 @@
+package geo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"oikotie/database"
+
+	"github.com/lib/pq"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// Geofence is a named polygon searched for listings. The geometry is a
+// GeoJSON Polygon or MultiPolygon in WGS84
+type Geofence struct {
+	Name     string
+	Geometry json.RawMessage
+}
+
+// SaveGeofences stores the geofences by name, replacing the polygons of the
+// stored ones, and returns their ids in order
+func SaveGeofences(ctx context.Context, db *sql.DB, fences []Geofence) ([]int, error) {
+	ids := make([]int, len(fences))
+
+	err := transaction.Do(ctx, db, func(tx *sql.Tx) error {
+		for i, f := range fences {
+			var row struct {
+				ID int `boil:"id"`
+			}
+			err := queries.Raw(`
+				INSERT INTO geofences(name, geom)
+				VALUES ($1, ST_Multi(ST_CollectionExtract(ST_MakeValid(ST_SetSRID(ST_GeomFromGeoJSON($2::text), 4326)), 3)))
+				ON CONFLICT (name) DO UPDATE
+				SET geom = EXCLUDED.geom, updated_at = NOW()
+				WHERE NOT ST_Equals(geofences.geom, EXCLUDED.geom)
+				RETURNING id`,
+				f.Name, string(f.Geometry),
+			).Bind(ctx, tx, &row)
+			if err == sql.ErrNoRows {
+				// Unchanged, the conflicting row isn't returned
+				err = queries.Raw(`SELECT id FROM geofences WHERE name = $1`, f.Name).Bind(ctx, tx, &row)
+			}
+			if err != nil {
+				return fmt.Errorf("Geofence %q: %w", f.Name, err)
+			}
+			ids[i] = row.ID
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// Cover is a boundary overlapping a geofence
+type Cover struct {
+	AreaID null.Int `boil:"area_id"`
+	Code   string   `boil:"code"`
+}
+
+// CoveringBoundaries returns the imported boundaries of the card type that
+// overlap the geofence, which together cover it as far as they reach
+func CoveringBoundaries(ctx context.Context, db boil.ContextExecutor, geofenceID int, cardType int) ([]Cover, error) {
+	var covers []Cover
+	err := queries.Raw(`
+		SELECT g.area_id, g.code
+		FROM area_geometries g
+		JOIN geofences f ON ST_Intersects(g.geom, f.geom)
+		WHERE f.id = $1 AND g.card_type = $2
+		ORDER BY g.code`,
+		geofenceID, cardType,
+	).Bind(ctx, db, &covers)
+	if err != nil {
+		return nil, err
+	}
+
+	return covers, nil
+}
+
+// GeofenceAt returns the first of the geofences that contains the point, null
+// when none does
+func GeofenceAt(ctx context.Context, db boil.ContextExecutor, geofenceIDs []int, latitude float64, longitude float64) (null.Int, error) {
+	ids := make([]int64, len(geofenceIDs))
+	for i, id := range geofenceIDs {
+		ids[i] = int64(id)
+	}
+
+	var row struct {
+		ID int `boil:"id"`
+	}
+	err := queries.Raw(`
+		SELECT id FROM geofences
+		WHERE id = ANY($1) AND ST_Contains(geom, ST_SetSRID(ST_MakePoint($2, $3), 4326))
+		ORDER BY array_position($1, id)
+		LIMIT 1`,
+		pq.Array(ids), longitude, latitude,
+	).Bind(ctx, db, &row)
+	if err == sql.ErrNoRows {
+		return null.Int{}, nil
+	}
+	if err != nil {
+		return null.Int{}, err
+	}
+
+	return null.IntFrom(row.ID), nil
+}
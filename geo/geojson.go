@@ -0,0 +1,107 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type geoJSONFile struct {
+	Type     string             `json:"type"`
+	CRS      *geoJSONCRS        `json:"crs"`
+	Features []geoJSONFeature   `json:"features"`
+	Geometry json.RawMessage    `json:"geometry"`
+	Props    map[string]jsonAny `json:"properties"`
+}
+
+type geoJSONFeature struct {
+	Geometry json.RawMessage    `json:"geometry"`
+	Props    map[string]jsonAny `json:"properties"`
+}
+
+type geoJSONCRS struct {
+	Properties struct {
+		Name string `json:"name"`
+	} `json:"properties"`
+}
+
+// jsonAny is a property value of any type, kept as its text
+type jsonAny string
+
+func (v *jsonAny) UnmarshalJSON(b []byte) error {
+	var s string
+	if json.Unmarshal(b, &s) == nil {
+		*v = jsonAny(s)
+		return nil
+	}
+	if string(b) == "null" {
+		*v = ""
+		return nil
+	}
+
+	*v = jsonAny(b)
+	return nil
+}
+
+// The EPSG code of a named CRS, e.g. "urn:ogc:def:crs:EPSG::3067" or "EPSG:3067"
+var epsgReg = regexp.MustCompile(`(?i)EPSG:+([0-9]+)$`)
+
+func readGeoJSON(path string) (Layer, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Layer{}, err
+	}
+
+	var file geoJSONFile
+	err = json.Unmarshal(b, &file)
+	if err != nil {
+		return Layer{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	features := file.Features
+	if file.Type == "Feature" {
+		features = []geoJSONFeature{{Geometry: file.Geometry, Props: file.Props}}
+	} else if file.Type != "FeatureCollection" {
+		return Layer{}, fmt.Errorf("%s: expected a FeatureCollection or a Feature, got %q", path, file.Type)
+	}
+
+	// GeoJSON is WGS84 unless an older style crs member says otherwise
+	layer := Layer{SRID: WGS84}
+	if file.CRS != nil {
+		layer.SRID = 0
+		if m := epsgReg.FindStringSubmatch(file.CRS.Properties.Name); m != nil {
+			layer.SRID, _ = strconv.Atoi(m[1])
+		} else if strings.Contains(file.CRS.Properties.Name, "CRS84") {
+			layer.SRID = WGS84
+		}
+	}
+
+	for i, f := range features {
+		if len(f.Geometry) == 0 || string(f.Geometry) == "null" {
+			continue
+		}
+
+		var geometry struct {
+			Type string `json:"type"`
+		}
+		err = json.Unmarshal(f.Geometry, &geometry)
+		if err != nil {
+			return Layer{}, fmt.Errorf("%s: feature %d: %w", path, i, err)
+		}
+		if geometry.Type != "Polygon" && geometry.Type != "MultiPolygon" {
+			return Layer{}, fmt.Errorf("%s: feature %d: expected a Polygon or a MultiPolygon, got %q", path, i, geometry.Type)
+		}
+
+		props := map[string]string{}
+		for key, value := range f.Props {
+			props[key] = string(value)
+		}
+
+		layer.Features = append(layer.Features, Feature{Properties: props, Geometry: f.Geometry})
+	}
+
+	return layer, nil
+}
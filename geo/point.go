@@ -0,0 +1,98 @@
+package geo
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Point is a location in WGS84 coordinates
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// NullPoint is a nullable Point stored as a PostGIS geography(Point)
+type NullPoint struct {
+	Point
+	Valid bool
+}
+
+// NewNullPoint creates a valid NullPoint
+func NewNullPoint(latitude float64, longitude float64) NullPoint {
+	return NullPoint{Point{latitude, longitude}, true}
+}
+
+// Value writes the point as EWKT, which geography columns accept as input
+func (p NullPoint) Value() (driver.Value, error) {
+	if !p.Valid {
+		return nil, nil
+	}
+	return p.EWKT(), nil
+}
+
+// EWKT is the point as extended well-known text, longitude first
+func (p Point) EWKT() string {
+	return fmt.Sprintf("SRID=%d;POINT(%s %s)", WGS84,
+		strconv.FormatFloat(p.Longitude, 'f', -1, 64),
+		strconv.FormatFloat(p.Latitude, 'f', -1, 64))
+}
+
+// ewkbSRID flags a geometry type followed by an SRID
+const ewkbSRID = 0x20000000
+
+// Scan reads the hex encoded EWKB that PostGIS outputs geographies as
+func (p *NullPoint) Scan(src interface{}) error {
+	var encoded []byte
+	switch src := src.(type) {
+	case nil:
+		*p = NullPoint{}
+		return nil
+	case string:
+		encoded = []byte(src)
+	case []byte:
+		encoded = src
+	default:
+		return fmt.Errorf("cannot scan %T into a point", src)
+	}
+
+	b := make([]byte, hex.DecodedLen(len(encoded)))
+	_, err := hex.Decode(b, encoded)
+	if err != nil {
+		return fmt.Errorf("point: %w", err)
+	}
+
+	if len(b) < 5 {
+		return errors.New("point: truncated EWKB")
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if b[0] == 0 {
+		order = binary.BigEndian
+	}
+
+	geomType := order.Uint32(b[1:])
+	b = b[5:]
+	if geomType&0x0fffffff != 1 {
+		return fmt.Errorf("point: expected a point, got geometry type %d", geomType&0x0fffffff)
+	}
+	if geomType&ewkbSRID != 0 {
+		if len(b) < 4 {
+			return errors.New("point: truncated EWKB")
+		}
+		b = b[4:]
+	}
+
+	if len(b) < 16 {
+		return errors.New("point: truncated EWKB")
+	}
+	*p = NewNullPoint(
+		math.Float64frombits(order.Uint64(b[8:])),
+		math.Float64frombits(order.Uint64(b)),
+	)
+
+	return nil
+}
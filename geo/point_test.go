@@ -0,0 +1,112 @@
+package geo
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"strings"
+	"testing"
+)
+
+// ewkb encodes a point as hex EWKB, with the SRID when it's not 0
+func ewkb(order binary.ByteOrder, geomType uint32, srid uint32, x float64, y float64) string {
+	b := []byte{1}
+	if order == binary.BigEndian {
+		b[0] = 0
+	}
+
+	word := make([]byte, 8)
+	if srid != 0 {
+		geomType |= ewkbSRID
+	}
+	order.PutUint32(word, geomType)
+	b = append(b, word[:4]...)
+	if srid != 0 {
+		order.PutUint32(word, srid)
+		b = append(b, word[:4]...)
+	}
+	order.PutUint64(word, math.Float64bits(x))
+	b = append(b, word...)
+	order.PutUint64(word, math.Float64bits(y))
+	b = append(b, word...)
+
+	return hex.EncodeToString(b)
+}
+
+func TestNullPointScan(t *testing.T) {
+	want := NewNullPoint(60.1699, 24.9384)
+
+	tests := []struct {
+		name string
+		src  interface{}
+	}{
+		{"little-endian with SRID", ewkb(binary.LittleEndian, 1, WGS84, 24.9384, 60.1699)},
+		{"little-endian without SRID", ewkb(binary.LittleEndian, 1, 0, 24.9384, 60.1699)},
+		{"big-endian with SRID", ewkb(binary.BigEndian, 1, WGS84, 24.9384, 60.1699)},
+		{"big-endian without SRID", ewkb(binary.BigEndian, 1, 0, 24.9384, 60.1699)},
+		{"bytes", []byte(ewkb(binary.LittleEndian, 1, WGS84, 24.9384, 60.1699))},
+		{"upper case hex", strings.ToUpper(ewkb(binary.LittleEndian, 1, WGS84, 24.9384, 60.1699))},
+	}
+
+	for _, tt := range tests {
+		var p NullPoint
+		err := p.Scan(tt.src)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if p != want {
+			t.Errorf("%s: got %+v, want %+v", tt.name, p, want)
+		}
+	}
+}
+
+func TestNullPointScanNull(t *testing.T) {
+	p := NewNullPoint(60, 24)
+	err := p.Scan(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Valid {
+		t.Errorf("scanning NULL gave %+v, want an invalid point", p)
+	}
+}
+
+func TestNullPointScanErrors(t *testing.T) {
+	full := ewkb(binary.LittleEndian, 1, WGS84, 24.9384, 60.1699)
+
+	tests := []struct {
+		name string
+		src  interface{}
+	}{
+		{"not hex", "POINT(24.9 60.1)"},
+		{"empty", ""},
+		{"truncated type", full[:6]},
+		{"truncated SRID", full[:14]},
+		{"truncated coordinates", full[:len(full)-2]},
+		{"polygon", ewkb(binary.LittleEndian, 3, WGS84, 0, 0)},
+		{"not a string", 42},
+	}
+
+	for _, tt := range tests {
+		var p NullPoint
+		if err := p.Scan(tt.src); err == nil {
+			t.Errorf("%s: scanned %+v, want an error", tt.name, p)
+		}
+	}
+}
+
+func TestNullPointValue(t *testing.T) {
+	v, err := NewNullPoint(60.1699, 24.9384).Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "SRID=4326;POINT(24.9384 60.1699)" {
+		t.Errorf("Value() = %v", v)
+	}
+
+	v, err = NullPoint{}.Value()
+	if err != nil || v != nil {
+		t.Errorf("Value() of an invalid point = %v, %v, want nil", v, err)
+	}
+}
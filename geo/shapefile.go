@@ -0,0 +1,302 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// Shape types of polygons, the Z and M variants have the same x and y layout
+const (
+	nullShape    = 0
+	polygonShape = 5
+	polygonZ     = 15
+	polygonM     = 25
+)
+
+// prjSRIDs maps the projection names of .prj files to SRIDs, checked in order
+var prjSRIDs = []struct {
+	name string
+	srid int
+}{
+	{"TM35FIN", 3067},
+	{"GK25", 3879},
+	{"GK24", 3878},
+	{"GK26", 3880},
+	{"Web_Mercator", 3857},
+	{"WGS_1984", WGS84},
+}
+
+// readShapefile reads the polygons of a .shp file and their attributes from
+// the .dbf file next to it. The SRID is recognised from the .prj file for
+// the projections used in Finland
+func readShapefile(path string) (Layer, error) {
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+
+	shapes, err := readShapes(path)
+	if err != nil {
+		return Layer{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	records, err := readDBF(base + ".dbf")
+	if err != nil && !os.IsNotExist(err) {
+		return Layer{}, fmt.Errorf("%s.dbf: %w", base, err)
+	}
+	if records != nil && len(records) != len(shapes) {
+		return Layer{}, fmt.Errorf("%s.dbf has %d records for %d shapes", base, len(records), len(shapes))
+	}
+
+	layer := Layer{}
+	if prj, err := ioutil.ReadFile(base + ".prj"); err == nil {
+		for _, p := range prjSRIDs {
+			if strings.Contains(string(prj), p.name) {
+				layer.SRID = p.srid
+				break
+			}
+		}
+	}
+
+	for i, shape := range shapes {
+		if shape == nil {
+			continue
+		}
+
+		props := map[string]string{}
+		if records != nil {
+			props = records[i]
+		}
+
+		layer.Features = append(layer.Features, Feature{Properties: props, Geometry: shape})
+	}
+
+	return layer, nil
+}
+
+type point [2]float64
+
+// readShapes reads the polygons of a .shp file as GeoJSON MultiPolygons, null
+// shapes are nil
+func readShapes(path string) ([]json.RawMessage, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) < 100 || binary.BigEndian.Uint32(b) != 9994 {
+		return nil, errors.New("not a shapefile")
+	}
+
+	shapeType := binary.LittleEndian.Uint32(b[32:])
+	if shapeType != polygonShape && shapeType != polygonZ && shapeType != polygonM {
+		return nil, fmt.Errorf("expected polygons, got shape type %d", shapeType)
+	}
+
+	shapes := []json.RawMessage{}
+	for offset := 100; offset+8 <= len(b); {
+		length := int(binary.BigEndian.Uint32(b[offset+4:])) * 2
+		content := b[offset+8:]
+		if length < 4 || len(content) < length {
+			return nil, fmt.Errorf("record %d is truncated", len(shapes)+1)
+		}
+		content = content[:length]
+		offset += 8 + length
+
+		if binary.LittleEndian.Uint32(content) == nullShape {
+			shapes = append(shapes, nil)
+			continue
+		}
+
+		rings, err := readRings(content)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", len(shapes)+1, err)
+		}
+
+		geometry, err := json.Marshal(struct {
+			Type        string      `json:"type"`
+			Coordinates [][][]point `json:"coordinates"`
+		}{"MultiPolygon", polygons(rings)})
+		if err != nil {
+			return nil, err
+		}
+
+		shapes = append(shapes, geometry)
+	}
+
+	return shapes, nil
+}
+
+// readRings reads the rings of a polygon record
+func readRings(content []byte) ([][]point, error) {
+	// Shape type, bounding box, part count and point count
+	if len(content) < 44 {
+		return nil, errors.New("truncated polygon")
+	}
+
+	numParts := int(binary.LittleEndian.Uint32(content[36:]))
+	numPoints := int(binary.LittleEndian.Uint32(content[40:]))
+	pointsAt := 44 + 4*numParts
+	if numParts < 0 || numPoints < 0 || len(content) < pointsAt+16*numPoints {
+		return nil, errors.New("truncated polygon")
+	}
+
+	points := make([]point, numPoints)
+	for i := range points {
+		at := pointsAt + 16*i
+		points[i] = point{
+			math.Float64frombits(binary.LittleEndian.Uint64(content[at:])),
+			math.Float64frombits(binary.LittleEndian.Uint64(content[at+8:])),
+		}
+	}
+
+	rings := make([][]point, numParts)
+	for i := range rings {
+		start := int(binary.LittleEndian.Uint32(content[44+4*i:]))
+		end := numPoints
+		if i+1 < numParts {
+			end = int(binary.LittleEndian.Uint32(content[44+4*(i+1):]))
+		}
+		if start < 0 || start > end || end > numPoints {
+			return nil, fmt.Errorf("part %d is out of bounds", i)
+		}
+		rings[i] = points[start:end]
+	}
+
+	return rings, nil
+}
+
+// polygons groups the rings of a shape into polygons. Outer rings are
+// clockwise, holes counterclockwise and belong to the outer ring they're in
+func polygons(rings [][]point) [][][]point {
+	polygons := [][][]point{}
+	holes := [][]point{}
+	for _, ring := range rings {
+		if len(ring) < 4 {
+			continue
+		}
+		if signedArea(ring) <= 0 {
+			polygons = append(polygons, [][]point{ring})
+		} else {
+			holes = append(holes, ring)
+		}
+	}
+
+	for _, hole := range holes {
+		outer := -1
+		for i, polygon := range polygons {
+			if contains(polygon[0], hole[0]) {
+				outer = i
+				break
+			}
+		}
+
+		// A hole outside every outer ring is a wrongly wound outer ring
+		if outer == -1 {
+			reversed := make([]point, len(hole))
+			for i, p := range hole {
+				reversed[len(hole)-1-i] = p
+			}
+			polygons = append(polygons, [][]point{reversed})
+			continue
+		}
+		polygons[outer] = append(polygons[outer], hole)
+	}
+
+	return polygons
+}
+
+// signedArea is twice the area of the ring, negative when it's clockwise
+func signedArea(ring []point) float64 {
+	area := 0.0
+	for i := 0; i+1 < len(ring); i++ {
+		area += ring[i][0]*ring[i+1][1] - ring[i+1][0]*ring[i][1]
+	}
+	return area
+}
+
+// contains reports whether the point is inside the ring, by ray casting
+func contains(ring []point, p point) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a[1] > p[1]) != (b[1] > p[1]) && p[0] < (b[0]-a[0])*(p[1]-a[1])/(b[1]-a[1])+a[0] {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// readDBF reads the attributes of a .dbf file, one map per record in order.
+// Deleted records are kept, as the records line up with the shapes by index
+func readDBF(path string) ([]map[string]string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) < 32 {
+		return nil, errors.New("not a dBASE file")
+	}
+
+	count := int(binary.LittleEndian.Uint32(b[4:]))
+	headerLength := int(binary.LittleEndian.Uint16(b[8:]))
+	recordLength := int(binary.LittleEndian.Uint16(b[10:]))
+	if headerLength > len(b) {
+		return nil, errors.New("truncated header")
+	}
+
+	type field struct {
+		name   string
+		length int
+	}
+	fields := []field{}
+	for at := 32; at+32 <= headerLength && b[at] != 0x0d; at += 32 {
+		name := b[at : at+11]
+		if i := bytes.IndexByte(name, 0); i >= 0 {
+			name = name[:i]
+		}
+		fields = append(fields, field{decodeText(name), int(b[at+16])})
+	}
+
+	records := make([]map[string]string, count)
+	for i := range records {
+		at := headerLength + i*recordLength
+		if at+recordLength > len(b) {
+			return nil, fmt.Errorf("record %d is truncated", i+1)
+		}
+
+		// The first byte is the deletion flag
+		at++
+		record := map[string]string{}
+		for _, f := range fields {
+			if at+f.length > len(b) {
+				return nil, fmt.Errorf("record %d is truncated", i+1)
+			}
+			record[f.name] = strings.TrimSpace(decodeText(b[at : at+f.length]))
+			at += f.length
+		}
+		records[i] = record
+	}
+
+	return records, nil
+}
+
+// decodeText decodes UTF-8 text, or Latin-1 text which older files use
+func decodeText(b []byte) string {
+	if utf8.Valid(b) {
+		return string(b)
+	}
+
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
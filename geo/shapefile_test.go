@@ -0,0 +1,124 @@
+package geo
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// testdata/areas.shp has three records in TM35FIN: a clockwise square with a
+// counterclockwise hole, a null shape and a lone counterclockwise ring
+func TestReadShapefile(t *testing.T) {
+	layer, err := Read("testdata/areas.shp")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if layer.SRID != 3067 {
+		t.Errorf("SRID = %d, want 3067", layer.SRID)
+	}
+	if len(layer.Features) != 2 {
+		t.Fatalf("got %d features, want 2 as the null shape is skipped", len(layer.Features))
+	}
+
+	tests := []struct {
+		code     string
+		name     string
+		polygons []int
+	}{
+		// The hole belongs to the square
+		{"00100", "Helsinki keskusta", []int{2}},
+		// The wrongly wound ring is an outer ring of its own
+		{"00120", "Töölö", []int{1}},
+	}
+
+	for i, tt := range tests {
+		f := layer.Features[i]
+		if code, _ := f.Property("postinro"); code != tt.code {
+			t.Errorf("feature %d code = %q, want %q", i, code, tt.code)
+		}
+		if name, _ := f.Property("nimi"); name != tt.name {
+			t.Errorf("feature %d name = %q, want %q", i, name, tt.name)
+		}
+
+		var geometry struct {
+			Type        string
+			Coordinates [][][]point
+		}
+		err := json.Unmarshal(f.Geometry, &geometry)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if geometry.Type != "MultiPolygon" {
+			t.Errorf("feature %d type = %q, want MultiPolygon", i, geometry.Type)
+		}
+
+		rings := []int{}
+		for _, polygon := range geometry.Coordinates {
+			rings = append(rings, len(polygon))
+			if signedArea(polygon[0]) > 0 {
+				t.Errorf("feature %d has a counterclockwise outer ring", i)
+			}
+		}
+		if len(rings) != len(tt.polygons) || rings[0] != tt.polygons[0] {
+			t.Errorf("feature %d rings per polygon = %v, want %v", i, rings, tt.polygons)
+		}
+	}
+}
+
+func TestPolygons(t *testing.T) {
+	square := func(x, y, size float64, clockwise bool) []point {
+		ring := []point{{x, y}, {x + size, y}, {x + size, y + size}, {x, y + size}, {x, y}}
+		if clockwise {
+			for i, j := 0, len(ring)-1; i < j; i, j = i+1, j-1 {
+				ring[i], ring[j] = ring[j], ring[i]
+			}
+		}
+		return ring
+	}
+
+	tests := []struct {
+		name  string
+		rings [][]point
+		want  []int
+	}{
+		{"outer ring", [][]point{square(0, 0, 10, true)}, []int{1}},
+		{"hole", [][]point{square(0, 0, 10, true), square(2, 2, 2, false)}, []int{2}},
+		{"hole before its outer ring", [][]point{square(2, 2, 2, false), square(0, 0, 10, true)}, []int{2}},
+		{"hole in the second outer ring", [][]point{square(0, 0, 10, true), square(20, 0, 10, true), square(22, 2, 2, false)}, []int{1, 2}},
+		{"wrongly wound ring", [][]point{square(0, 0, 10, false)}, []int{1}},
+		{"degenerate ring", [][]point{{{0, 0}, {1, 1}, {0, 0}}}, []int{}},
+	}
+
+	for _, tt := range tests {
+		got := polygons(tt.rings)
+		rings := []int{}
+		for _, polygon := range got {
+			rings = append(rings, len(polygon))
+			if signedArea(polygon[0]) > 0 {
+				t.Errorf("%s: counterclockwise outer ring", tt.name)
+			}
+		}
+		if len(rings) != len(tt.want) {
+			t.Errorf("%s: rings per polygon = %v, want %v", tt.name, rings, tt.want)
+			continue
+		}
+		for i := range rings {
+			if rings[i] != tt.want[i] {
+				t.Errorf("%s: rings per polygon = %v, want %v", tt.name, rings, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestReadShapefileErrors(t *testing.T) {
+	_, err := Read("testdata/areas.prj")
+	if err == nil {
+		t.Error("reading a .prj file succeeded")
+	}
+
+	_, err = readShapes("testdata/areas.dbf")
+	if err == nil {
+		t.Error("reading a .dbf file as shapes succeeded")
+	}
+}
@@ -0,0 +1,157 @@
+package geo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"oikotie/database"
+	"sort"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// ImportOptions tells which kind of areas a layer has and which properties
+// identify them. Each property is a list of names, the first one a feature
+// has is used
+type ImportOptions struct {
+	// CardType is the location card type of the areas, postcode or district
+	CardType int
+	Code     []string
+	Name     []string
+	// City is the city of all the areas, needed when district names repeat
+	// across cities
+	City string
+	// SRID overrides the SRID of the layer
+	SRID   int
+	Source string
+}
+
+// Import stores the features of a layer as area boundaries, replacing the
+// boundaries of the same areas imported before
+func Import(ctx context.Context, db *sql.DB, layer Layer, o ImportOptions) (int, error) {
+	srid := layer.SRID
+	if o.SRID != 0 {
+		srid = o.SRID
+	}
+	if srid == 0 {
+		return 0, fmt.Errorf("The coordinate system of %s is not known, give its SRID", o.Source)
+	}
+
+	imported := 0
+	err := transaction.Do(ctx, db, func(tx *sql.Tx) error {
+		for i, f := range layer.Features {
+			code, ok := f.Property(o.Code...)
+			if !ok || code == "" {
+				return fmt.Errorf("Feature %d has none of the code properties %s, it has %s", i+1, strings.Join(o.Code, ", "), propertyNames(f))
+			}
+			name, ok := f.Property(o.Name...)
+			if !ok {
+				name = code
+			}
+
+			_, err := queries.Raw(`
+				INSERT INTO area_geometries(card_type, code, name, city, source, geom)
+				VALUES ($1, $2, $3, $4, $5, ST_Multi(ST_CollectionExtract(ST_MakeValid(
+					ST_Transform(ST_SetSRID(ST_GeomFromGeoJSON($6::text), $7::int), 4326)), 3)))
+				ON CONFLICT (card_type, city, code) DO UPDATE
+				SET name = EXCLUDED.name, source = EXCLUDED.source, imported_at = NOW(), geom = EXCLUDED.geom`,
+				o.CardType, code, name, o.City, o.Source, string(f.Geometry), srid,
+			).ExecContext(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("Feature %d (%s): %w", i+1, code, err)
+			}
+			imported++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return imported, nil
+}
+
+func propertyNames(f Feature) string {
+	names := []string{}
+	for name := range f.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// LinkAreas links the boundaries to the known areas of the same card type
+// they're of, by code for postcodes, else by name and city. A boundary that
+// matches several areas, e.g. a district name shared by two cities when the
+// boundary has no city, is left unlinked
+func LinkAreas(ctx context.Context, db boil.ContextExecutor) (int64, error) {
+	res, err := queries.Raw(`
+		UPDATE area_geometries g SET area_id = m.area_id
+		FROM (
+			SELECT g.id, min(a.id) AS area_id
+			FROM area_geometries g
+			JOIN areas a ON a.card_type = g.card_type AND (
+				split_part(a.name, ' ', 1) = g.code
+				OR (lower(a.name) = lower(g.name) AND (g.city = '' OR lower(a.city) = lower(g.city)))
+			)
+			GROUP BY g.id
+			HAVING count(DISTINCT a.id) = 1
+		) m
+		WHERE g.id = m.id AND g.area_id IS DISTINCT FROM m.area_id`,
+	).ExecContext(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// AssignListings moves every listing to the most specific linked area whose
+// boundary contains it. Listings outside all boundaries are left as they are.
+// Only what a listing is attributed to changes, whether it's removed is
+// decided by the searches that return it
+func AssignListings(ctx context.Context, db boil.ContextExecutor) (int64, error) {
+	res, err := queries.Raw(`
+		UPDATE listings l SET area_id = m.area_id
+		FROM (
+			SELECT DISTINCT ON (l.id) l.id, g.area_id
+			FROM listings l
+			JOIN area_geometries g ON g.area_id IS NOT NULL
+				AND ST_Contains(g.geom, l.coord::geometry)
+			WHERE l.coord IS NOT NULL
+			ORDER BY l.id, ST_Area(g.geom)
+		) m
+		WHERE l.id = m.id AND l.area_id <> m.area_id`,
+	).ExecContext(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// AreaAt returns the id of the most specific linked area whose boundary
+// contains the point, null when there is none
+func AreaAt(ctx context.Context, db boil.ContextExecutor, latitude float64, longitude float64) (null.Int, error) {
+	var row struct {
+		AreaID int `boil:"area_id"`
+	}
+	err := queries.Raw(`
+		SELECT area_id FROM area_geometries
+		WHERE area_id IS NOT NULL AND ST_Contains(geom, ST_SetSRID(ST_MakePoint($1, $2), 4326))
+		ORDER BY ST_Area(geom)
+		LIMIT 1`,
+		longitude, latitude,
+	).Bind(ctx, db, &row)
+	if err == sql.ErrNoRows {
+		return null.Int{}, nil
+	}
+	if err != nil {
+		return null.Int{}, err
+	}
+
+	return null.IntFrom(row.AreaID), nil
+}
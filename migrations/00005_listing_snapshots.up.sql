@@ -0,0 +1,48 @@
+CREATE TABLE IF NOT EXISTS listing_snapshots(
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    listing_id INT NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    listing_data JSONB,
+    listing_details JSONB
+);
+
+CREATE INDEX idx_listing_snapshots_listing_id ON listing_snapshots(listing_id, created_at);
+
+-- The latest row of each external listing becomes the canonical one
+CREATE TEMPORARY TABLE canonical_listings AS
+SELECT DISTINCT ON (external_id) id, external_id
+FROM listings
+ORDER BY external_id, created_at DESC, id DESC;
+
+-- Move the history of duplicate rows into snapshots, skipping rows where nothing
+-- changed. The visit counts change on every scrape and are ignored, as when
+-- scraping
+INSERT INTO listing_snapshots(created_at, listing_id, listing_data, listing_details)
+SELECT created_at, canonical_id, listing_data, listing_details
+FROM (
+    SELECT
+        l.created_at,
+        c.id AS canonical_id,
+        l.listing_data,
+        l.listing_details,
+        l.listing_data - '{visits,visitsWeekly}'::text[] AS card,
+        LAG(l.listing_data - '{visits,visitsWeekly}'::text[]) OVER w AS prev_card,
+        LAG(l.listing_details) OVER w AS prev_details,
+        ROW_NUMBER() OVER w AS n
+    FROM listings l
+    JOIN canonical_listings c ON c.external_id = l.external_id
+    WINDOW w AS (PARTITION BY l.external_id ORDER BY l.created_at, l.id)
+) history
+WHERE n = 1
+    OR card IS DISTINCT FROM prev_card
+    OR listing_details IS DISTINCT FROM prev_details
+ORDER BY created_at;
+
+DELETE FROM listings l
+USING canonical_listings c
+WHERE l.external_id = c.external_id AND l.id <> c.id;
+
+DROP TABLE canonical_listings;
+
+DROP INDEX IF EXISTS idx_listings_external_id_compound;
+ALTER TABLE listings ADD CONSTRAINT listings_external_id_key UNIQUE (external_id);
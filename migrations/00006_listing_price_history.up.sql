@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS listing_price_history(
+    id SERIAL PRIMARY KEY,
+    listing_id INT NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    price INT NOT NULL,
+    seen_on DATE NOT NULL DEFAULT CURRENT_DATE,
+    CONSTRAINT listing_price_history_listing_id_seen_on_key UNIQUE (listing_id, seen_on)
+);
+
+INSERT INTO listing_price_history(listing_id, price, seen_on)
+SELECT id, price, date_accessed
+FROM listings
+WHERE price > 0;
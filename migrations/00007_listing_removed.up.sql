@@ -0,0 +1,4 @@
+ALTER TABLE listings ADD COLUMN removed_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE listings ADD COLUMN removed_price INT;
+
+CREATE INDEX idx_listings_active_area_id ON listings(area_id) WHERE removed_at IS NULL;
@@ -0,0 +1,6 @@
+ALTER TABLE listings ADD COLUMN kind TEXT NOT NULL DEFAULT 'sale' CHECK (kind IN ('sale', 'rent'));
+ALTER TABLE listings ADD COLUMN monthly_rent INT;
+ALTER TABLE listings ADD COLUMN deposit INT;
+ALTER TABLE listings ADD COLUMN min_lease_months INT;
+
+CREATE INDEX idx_listings_kind ON listings(kind);
@@ -0,0 +1,13 @@
+ALTER TABLE listings DROP CONSTRAINT listings_kind_check;
+ALTER TABLE listings ADD CONSTRAINT listings_kind_check CHECK (kind IN ('sale', 'rent', 'plot'));
+
+ALTER TABLE listings ADD COLUMN property_type TEXT NOT NULL DEFAULT 'apartment'
+    CHECK (property_type IN ('apartment', 'row_house', 'semi_detached_house', 'detached_house', 'plot'));
+ALTER TABLE listings ADD COLUMN lot_size DOUBLE PRECISION;
+ALTER TABLE listings ADD COLUMN floor_count INT;
+
+-- Floors and rooms don't apply to every property type
+ALTER TABLE listings ALTER COLUMN floor DROP NOT NULL;
+ALTER TABLE listings ALTER COLUMN rooms DROP NOT NULL;
+
+CREATE INDEX idx_listings_property_type ON listings(property_type);
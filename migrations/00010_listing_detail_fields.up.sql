@@ -0,0 +1,21 @@
+-- The floor count of houses is the same thing as the floor count of apartment buildings
+ALTER TABLE listings RENAME COLUMN floor_count TO total_floors;
+
+ALTER TABLE listings ADD COLUMN construction_year INT;
+-- Fees are in euros per month
+ALTER TABLE listings ADD COLUMN maintenance_fee DOUBLE PRECISION;
+ALTER TABLE listings ADD COLUMN financing_fee DOUBLE PRECISION;
+ALTER TABLE listings ADD COLUMN debt_share INT;
+ALTER TABLE listings ADD COLUMN debt_free_price INT;
+ALTER TABLE listings ADD COLUMN condition TEXT
+    CHECK (condition IN ('excellent', 'good', 'satisfactory', 'passable', 'poor'));
+ALTER TABLE listings ADD COLUMN energy_class TEXT;
+ALTER TABLE listings ADD COLUMN has_elevator BOOLEAN;
+ALTER TABLE listings ADD COLUMN has_sauna BOOLEAN;
+ALTER TABLE listings ADD COLUMN has_balcony BOOLEAN;
+ALTER TABLE listings ADD COLUMN lot_ownership TEXT
+    CHECK (lot_ownership IN ('own', 'rented', 'optionalRent'));
+-- Lot rent is in euros per year
+ALTER TABLE listings ADD COLUMN lot_rent DOUBLE PRECISION;
+
+CREATE INDEX idx_listings_construction_year ON listings(construction_year);
@@ -0,0 +1,4 @@
+-- Fields that failed to parse, keyed by field
+ALTER TABLE listings ADD COLUMN parse_errors JSONB;
+
+CREATE INDEX idx_listings_parse_errors ON listings(id) WHERE parse_errors IS NOT NULL;
@@ -0,0 +1,4 @@
+-- The version of the parser that derived the listing's fields, 0 is before versioning
+ALTER TABLE listings ADD COLUMN parser_version INT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_listings_parser_version ON listings(parser_version);
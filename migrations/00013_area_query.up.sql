@@ -0,0 +1,4 @@
+-- The configured query an area was resolved from, so it's only looked up once
+ALTER TABLE areas ADD COLUMN query TEXT UNIQUE;
+
+ALTER TABLE areas ADD CONSTRAINT areas_external_id_key UNIQUE (external_id);
@@ -0,0 +1,3 @@
+-- Tracked areas are scraped when the search config reads its areas from the DB
+ALTER TABLE areas ADD COLUMN tracked BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE areas ADD COLUMN last_scraped_at TIMESTAMPTZ;
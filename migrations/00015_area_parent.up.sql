@@ -0,0 +1,4 @@
+-- Areas form a hierarchy, e.g. region > municipality > district > postcode
+ALTER TABLE areas ADD COLUMN parent_id INT REFERENCES areas(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_areas_parent_id ON areas(parent_id);
@@ -0,0 +1,19 @@
+CREATE EXTENSION IF NOT EXISTS postgis;
+
+-- Boundaries of postcode and district areas, imported with ot geo import.
+-- Listings are placed in the linked area whose boundary contains them
+CREATE TABLE IF NOT EXISTS area_geometries(
+    id SERIAL PRIMARY KEY,
+    area_id INT REFERENCES areas(id) ON DELETE SET NULL,
+    card_type INT NOT NULL,
+    code TEXT NOT NULL,
+    name TEXT NOT NULL,
+    city TEXT NOT NULL DEFAULT '',
+    source TEXT NOT NULL,
+    imported_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    geom geometry(MultiPolygon, 4326) NOT NULL,
+    UNIQUE (card_type, city, code)
+);
+
+CREATE INDEX idx_area_geometries_area_id ON area_geometries(area_id);
+CREATE INDEX idx_area_geometries_geom ON area_geometries USING GIST(geom);
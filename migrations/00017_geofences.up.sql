@@ -0,0 +1,12 @@
+-- Named polygons of the search config, listings found through one record the
+-- first one they're in
+CREATE TABLE IF NOT EXISTS geofences(
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    geom geometry(MultiPolygon, 4326) NOT NULL
+);
+
+CREATE INDEX idx_geofences_geom ON geofences USING GIST(geom);
+
+ALTER TABLE listings ADD COLUMN geofence_id INT REFERENCES geofences(id) ON DELETE SET NULL;
@@ -0,0 +1,5 @@
+-- Points were stored as (latitude, longitude), geographies are longitude first
+ALTER TABLE listings ALTER COLUMN coord TYPE geography(Point, 4326)
+USING CASE WHEN coord IS NULL THEN NULL ELSE ST_SetSRID(ST_MakePoint(coord[1], coord[0]), 4326)::geography END;
+
+CREATE INDEX idx_listings_coord ON listings USING GIST(coord);
@@ -0,0 +1,16 @@
+-- The searched areas each listing was returned by. A listing's area_id is the
+-- area it's attributed to, which needn't be one that was searched, so removals
+-- are decided by the searches that saw it
+CREATE TABLE IF NOT EXISTS listing_searches(
+    area_id INT NOT NULL REFERENCES areas(id) ON DELETE CASCADE,
+    listing_id INT NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    last_seen_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (area_id, listing_id)
+);
+
+CREATE INDEX idx_listing_searches_listing_id ON listing_searches(listing_id);
+
+-- Until now listings were attributed to the area whose search returned them
+INSERT INTO listing_searches(area_id, listing_id, last_seen_at)
+SELECT area_id, id, COALESCE(created_at, NOW()) FROM listings
+WHERE removed_at IS NULL;
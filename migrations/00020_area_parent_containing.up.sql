@@ -0,0 +1,4 @@
+-- Districts and postcodes overlap rather than contain one another, an area
+-- below one of them was only placed there by whichever search found it first
+UPDATE areas SET parent_id = NULL
+WHERE parent_id IN (SELECT id FROM areas WHERE card_type IN (4, 5));
@@ -0,0 +1,3 @@
+-- Incremental runs stop paging early, so removals are only detected by the
+-- full scans an area gets now and then
+ALTER TABLE areas ADD COLUMN last_full_scan_at TIMESTAMPTZ;
@@ -0,0 +1,21 @@
+-- Prices from before the price history are in the snapshots, a card price is
+-- either a number or formatted, e.g. "123 456 €", of which the euros before
+-- any decimal comma or range are taken. The latest price of a day is kept,
+-- and days already in the history are left as they were
+INSERT INTO listing_price_history(listing_id, price, seen_on)
+SELECT listing_id, price, seen_on
+FROM (
+    SELECT
+        listing_id,
+        created_at,
+        created_at::date AS seen_on,
+        CASE jsonb_typeof(listing_data->'price')
+            WHEN 'number' THEN round((listing_data->>'price')::numeric)::int
+            WHEN 'string' THEN substring(translate(listing_data->>'price', ' ' || chr(160) || chr(8239), '') FROM '^[0-9]+')::int
+        END AS price
+    FROM listing_snapshots
+    WHERE created_at IS NOT NULL
+) snapshot_prices
+WHERE price > 0
+ORDER BY created_at DESC
+ON CONFLICT (listing_id, seen_on) DO NOTHING;
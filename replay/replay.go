@@ -0,0 +1,166 @@
+package replay
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Exchange is a recorded request and its response
+type Exchange struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Status int         `json:"status"`
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+}
+
+// key identifies a request by method, path and query, ignoring the host so that
+// recordings of several hosts can be served from one fake server
+func key(method string, u *url.URL) string {
+	sum := sha1.Sum([]byte(method + " " + u.EscapedPath() + "?" + u.RawQuery))
+	return hex.EncodeToString(sum[:])
+}
+
+// Recorder is an http.RoundTripper that saves every exchange to a directory,
+// one file per distinct request. Requests are told apart by method, path and
+// query only, a request to another host with the same path replaces the
+// recording of the first
+type Recorder struct {
+	dir  string
+	next http.RoundTripper
+}
+
+// NewRecorder records the exchanges made through next into dir
+func NewRecorder(dir string, next http.RoundTripper) (*Recorder, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create recording folder, %w", err)
+	}
+
+	return &Recorder{dir: dir, next: next}, nil
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	raw, err := json.MarshalIndent(Exchange{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Status: resp.StatusCode,
+		Header: resp.Header,
+		Body:   body,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	err = ioutil.WriteFile(filepath.Join(r.dir, key(req.Method, req.URL)+".json"), raw, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to save recording, %w", err)
+	}
+
+	return resp, nil
+}
+
+// Server serves recorded exchanges. Absolute URLs of the recorded hosts in
+// response bodies are rewritten to point at the server itself
+type Server struct {
+	exchanges map[string]Exchange
+	origins   []string
+}
+
+// Load reads all recordings from dir
+func Load(dir string) (*Server, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{exchanges: make(map[string]Exchange)}
+	hosts := make(map[string]struct{})
+
+	for _, file := range files {
+		raw, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		var e Exchange
+		err = json.Unmarshal(raw, &e)
+		if err != nil {
+			return nil, fmt.Errorf("%s, %w", file, err)
+		}
+
+		u, err := url.Parse(e.URL)
+		if err != nil {
+			return nil, fmt.Errorf("%s, %w", file, err)
+		}
+
+		s.exchanges[key(e.Method, u)] = e
+		hosts[u.Host] = struct{}{}
+	}
+
+	for host := range hosts {
+		s.origins = append(s.origins, "https://"+host, "http://"+host)
+	}
+
+	return s, nil
+}
+
+// Len returns the number of recorded exchanges
+func (s *Server) Len() int {
+	return len(s.exchanges)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e, ok := s.exchanges[key(r.Method, r.URL)]
+	if !ok {
+		log.Printf("No recording for %s %s", r.Method, r.URL)
+		http.NotFound(w, r)
+		return
+	}
+
+	body := e.Body
+	if isText(e.Header.Get("Content-Type")) {
+		self := "http://" + r.Host
+		for _, origin := range s.origins {
+			body = bytes.ReplaceAll(body, []byte(origin), []byte(self))
+		}
+	}
+
+	for k, values := range e.Header {
+		if k == "Content-Length" {
+			continue
+		}
+		for _, v := range values {
+			w.Header().Add(k, v)
+		}
+	}
+
+	w.WriteHeader(e.Status)
+	_, _ = w.Write(body)
+}
+
+func isText(contentType string) bool {
+	return strings.HasPrefix(contentType, "text/") || strings.Contains(contentType, "json")
+}
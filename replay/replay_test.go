@@ -0,0 +1,111 @@
+package replay
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func get(t *testing.T, client *http.Client, url string) (int, string) {
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, string(body)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var origin string
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/page":
+			w.Header().Set("Content-Type", "text/html")
+			fmt.Fprintf(w, `<a href="%s/listing/1?q=a">%s</a>`, origin, r.URL.Query().Get("q"))
+		case "/image":
+			w.Header().Set("Content-Type", "image/jpeg")
+			fmt.Fprint(w, origin)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer site.Close()
+	origin = site.URL
+
+	recorder, err := NewRecorder(dir, http.DefaultTransport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: recorder}
+
+	requests := []string{"/page?q=a", "/page?q=b", "/image", "/missing"}
+	recorded := map[string]string{}
+	for _, path := range requests {
+		_, body := get(t, client, site.URL+path)
+		recorded[path] = body
+	}
+
+	server, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if server.Len() != len(requests) {
+		t.Errorf("loaded %d recordings, want %d", server.Len(), len(requests))
+	}
+
+	fake := httptest.NewServer(server)
+	defer fake.Close()
+
+	tests := []struct {
+		path   string
+		status int
+		body   string
+	}{
+		// Links to the recorded host point at the fake server
+		{"/page?q=a", 200, fmt.Sprintf(`<a href="%s/listing/1?q=a">a</a>`, fake.URL)},
+		{"/page?q=b", 200, fmt.Sprintf(`<a href="%s/listing/1?q=a">b</a>`, fake.URL)},
+		// Binary bodies are served as recorded
+		{"/image", 200, recorded["/image"]},
+		{"/missing", 404, recorded["/missing"]},
+		// Not recorded
+		{"/page?q=c", 404, "404 page not found\n"},
+	}
+
+	for _, tt := range tests {
+		status, body := get(t, http.DefaultClient, fake.URL+tt.path)
+		if status != tt.status || body != tt.body {
+			t.Errorf("GET %s = %d %q, want %d %q", tt.path, status, body, tt.status, tt.body)
+		}
+	}
+}
+
+func TestKeyIgnoresHost(t *testing.T) {
+	a, _ := http.NewRequest("GET", "https://asunnot.oikotie.fi/api/cards?limit=24", nil)
+	b, _ := http.NewRequest("GET", "http://localhost:8080/api/cards?limit=24", nil)
+	c, _ := http.NewRequest("GET", "http://localhost:8080/api/cards?limit=12", nil)
+	d, _ := http.NewRequest("HEAD", "http://localhost:8080/api/cards?limit=24", nil)
+
+	if key(a.Method, a.URL) != key(b.Method, b.URL) {
+		t.Error("requests differing only by host have different keys")
+	}
+	if key(b.Method, b.URL) == key(c.Method, c.URL) {
+		t.Error("requests with different queries have the same key")
+	}
+	if key(b.Method, b.URL) == key(d.Method, d.URL) {
+		t.Error("requests with different methods have the same key")
+	}
+}
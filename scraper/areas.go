@@ -0,0 +1,310 @@
+package scraper
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"oikotie/database"
+	"oikotie/database/models"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Location card types
+const (
+	regionCard       = 3 // maakunta
+	districtCard     = 4 // kaupunginosa
+	postcodeCard     = 5 // postinumeroalue
+	municipalityCard = 6 // kunta
+)
+
+var acceptedCardIDs = map[int]interface{}{
+	regionCard:       struct{}{},
+	districtCard:     struct{}{},
+	postcodeCard:     struct{}{},
+	municipalityCard: struct{}{},
+}
+
+// cardTypeNames names the location card types for display
+var cardTypeNames = map[int]string{
+	regionCard:       "region",
+	districtCard:     "neighbourhood",
+	postcodeCard:     "postcode",
+	municipalityCard: "municipality",
+}
+
+// cardLevels orders the card types from the widest to the most specific
+var cardLevels = map[int]int{
+	regionCard:       1,
+	municipalityCard: 2,
+	districtCard:     3,
+	postcodeCard:     4,
+}
+
+// CardTypeName names a location card type, unknown types by their number
+func CardTypeName(cardType int) string {
+	if name, ok := cardTypeNames[cardType]; ok {
+		return name
+	}
+	return fmt.Sprintf("type %d", cardType)
+}
+
+// CardTypeByName is the location card type of a name given by CardTypeName
+func CardTypeByName(name string) (int, bool) {
+	for cardType, n := range cardTypeNames {
+		if n == name {
+			return cardType, true
+		}
+	}
+	return 0, false
+}
+
+type apiArea struct {
+	Card struct {
+		Name     string `json:"name"`
+		CardID   int    `json:"cardId"`
+		CardType int    `json:"cardType"`
+	} `json:"card"`
+	Parent struct {
+		Name string `json:"name"`
+	} `json:"parent"`
+}
+
+// AreaQuery is a configured area. The query is searched for with Oikotie's
+// location search, a CardID pins the query to one of its results
+type AreaQuery struct {
+	Query  string
+	CardID int
+}
+
+func (q AreaQuery) String() string {
+	if q.CardID != 0 {
+		return fmt.Sprintf("%q (card %d)", q.Query, q.CardID)
+	}
+	return fmt.Sprintf("%q", q.Query)
+}
+
+// AreaCandidate is a location search result
+type AreaCandidate struct {
+	CardID   int
+	CardType int
+	Name     string
+	City     string
+	// Accepted is whether listings can be scraped by the card type
+	Accepted bool
+}
+
+// SearchAreas returns every location card matching the query
+func (s *Scraper) SearchAreas(ctx context.Context, query string) ([]AreaCandidate, error) {
+	s.fetcher = newFetcher(s.fetchOptions)
+
+	err := s.startSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matching, err := s.searchLocations(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]AreaCandidate, len(matching))
+	for i, area := range matching {
+		_, accepted := acceptedCardIDs[area.Card.CardType]
+		candidates[i] = AreaCandidate{
+			CardID:   area.Card.CardID,
+			CardType: area.Card.CardType,
+			Name:     area.Card.Name,
+			City:     area.Parent.Name,
+			Accepted: accepted,
+		}
+	}
+
+	return candidates, nil
+}
+
+// getAreas resolves the configured areas, in order and without duplicates.
+// Resolved areas are stored with their query, so each is only looked up once
+func (s *Scraper) getAreas(ctx context.Context, queries []AreaQuery) ([]*models.Area, error) {
+	areas := []*models.Area{}
+	seen := map[int]bool{}
+
+	for _, q := range queries {
+		area, err := s.resolveArea(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+
+		if !seen[area.ID] {
+			seen[area.ID] = true
+			areas = append(areas, area)
+		}
+	}
+
+	return areas, nil
+}
+
+// AddArea resolves an area and marks it tracked
+func (s *Scraper) AddArea(ctx context.Context, q AreaQuery) (*models.Area, error) {
+	area, err := s.findArea(ctx, q)
+	if err == sql.ErrNoRows {
+		s.fetcher = newFetcher(s.fetchOptions)
+		err = s.startSession(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		area, err = s.resolveArea(ctx, q)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	area.Tracked = true
+	_, err = area.Update(ctx, s.db, boil.Whitelist(models.AreaColumns.Tracked))
+	if err != nil {
+		return nil, err
+	}
+
+	return area, nil
+}
+
+// getTrackedAreas returns the areas added with AddArea
+func (s *Scraper) getTrackedAreas(ctx context.Context) ([]*models.Area, error) {
+	areas, err := models.Areas(models.AreaWhere.Tracked.EQ(true), qm.OrderBy(models.AreaColumns.ID)).All(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	if len(areas) == 0 {
+		return nil, fmt.Errorf("No tracked areas, add them with ot areas add")
+	}
+
+	return areas, nil
+}
+
+// findArea finds an already resolved area, sql.ErrNoRows if there is none
+func (s *Scraper) findArea(ctx context.Context, q AreaQuery) (*models.Area, error) {
+	if q.CardID != 0 {
+		return models.Areas(models.AreaWhere.ExternalID.EQ(q.CardID)).One(ctx, s.db)
+	}
+	return models.Areas(models.AreaWhere.Query.EQ(null.StringFrom(q.Query))).One(ctx, s.db)
+}
+
+func (s *Scraper) resolveArea(ctx context.Context, q AreaQuery) (*models.Area, error) {
+	area, err := s.findArea(ctx, q)
+	if err == nil {
+		return area, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	if q.Query == "" {
+		return nil, fmt.Errorf("area card %d is not known, give the query it's found with", q.CardID)
+	}
+
+	found, err := s.getArea(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	area = newArea(found)
+	area.Query = null.StringFrom(q.Query)
+
+	// The query moves to the resolved area, also when the area was already
+	// resolved from another query or the query was pinned to another card
+	err = transaction.Do(ctx, s.db, func(tx *sql.Tx) error {
+		_, err := models.Areas(
+			models.AreaWhere.Query.EQ(area.Query),
+			models.AreaWhere.ExternalID.NEQ(area.ExternalID),
+		).UpdateAll(ctx, tx, models.M{models.AreaColumns.Query: nil})
+		if err != nil {
+			return err
+		}
+
+		return area.Upsert(ctx, tx, true, []string{models.AreaColumns.ExternalID}, boil.Whitelist(models.AreaColumns.Query), boil.Infer())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return area, nil
+}
+
+// newArea creates the area of a location card. The city of a municipality or
+// a region is the area itself, as it's what listing addresses are under
+func newArea(found apiArea) *models.Area {
+	city := found.Parent.Name
+	if found.Card.CardType == municipalityCard || found.Card.CardType == regionCard {
+		city = found.Card.Name
+	}
+
+	return &models.Area{
+		ExternalID: found.Card.CardID,
+		Name:       found.Card.Name,
+		CardType:   found.Card.CardType,
+		City:       city,
+	}
+}
+
+func (s *Scraper) searchLocations(ctx context.Context, query string) ([]apiArea, error) {
+	req := s.apiCall(ctx, "location")
+
+	q := req.URL.Query()
+	q.Add("query", query)
+
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := s.doAPI(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Request failed %d %s, location query: %s", resp.StatusCode, resp.Status, query)
+	}
+
+	var allMatching []apiArea
+	err = json.NewDecoder(resp.Body).Decode(&allMatching)
+	if err != nil {
+		return nil, err
+	}
+
+	return allMatching, nil
+}
+
+// getArea finds the card of a query: the pinned card, or else the only result
+// of an accepted card type
+func (s *Scraper) getArea(ctx context.Context, q AreaQuery) (apiArea, error) {
+	allMatching, err := s.searchLocations(ctx, q.Query)
+	if err != nil {
+		return apiArea{}, err
+	}
+
+	if q.CardID != 0 {
+		for _, area := range allMatching {
+			if area.Card.CardID == q.CardID {
+				return area, nil
+			}
+		}
+		return apiArea{}, fmt.Errorf("Card %d not found for %s, see ot areas search", q.CardID, q)
+	}
+
+	cityAreas := make([]apiArea, 0)
+	for _, area := range allMatching {
+		if _, ok := acceptedCardIDs[area.Card.CardType]; ok {
+			cityAreas = append(cityAreas, area)
+		}
+	}
+
+	if len(cityAreas) != 1 {
+		return apiArea{}, fmt.Errorf("Expected 1 card for %s, got %d, pin one with its card id, see ot areas search", q, len(cityAreas))
+	}
+
+	return cityAreas[0], nil
+}
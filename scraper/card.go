@@ -0,0 +1,165 @@
+package scraper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"oikotie/scraper/parse"
+	"regexp"
+
+	"github.com/volatiletech/null/v8"
+)
+
+// Card is a listing card of the cards API, typed as far as fields are derived
+// from it. The card is stored as it was received, not as decoded here
+type Card struct {
+	ID           int
+	Price        Price
+	Size         null.Float64
+	Rooms        null.Int
+	Visits       int
+	VisitsWeekly int
+	SubType      null.Int
+	Coordinates  Coordinates
+	Building     Building
+}
+
+type cardField struct {
+	name     string
+	dest     interface{}
+	required bool
+}
+
+// UnmarshalJSON decodes the card field by field so that errors name the
+// field that didn't match the expected shape. Every field is attempted, the
+// fields that failed are returned as ParseErrors
+func (c *Card) UnmarshalJSON(b []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(b, &fields)
+	if err != nil {
+		return fmt.Errorf("card: %w", err)
+	}
+
+	errs := ParseErrors{}
+
+	for _, f := range []cardField{
+		{"id", &c.ID, true},
+		{"price", &c.Price, true},
+		{"size", &c.Size, false},
+		{"rooms", &c.Rooms, false},
+		{"visits", &c.Visits, true},
+		{"visitsWeekly", &c.VisitsWeekly, false},
+		{"cardSubType", &c.SubType, false},
+		{"coordinates", &c.Coordinates, true},
+		{"buildingData", &c.Building, false},
+	} {
+		raw, ok := fields[f.name]
+		if !ok || string(raw) == "null" {
+			if f.required {
+				errs.add("card."+f.name, errMissing)
+			}
+			continue
+		}
+
+		err = json.Unmarshal(raw, f.dest)
+		if err != nil {
+			errs.add("card."+f.name, err)
+		}
+	}
+
+	return errs.orNil()
+}
+
+var errMissing = errors.New("missing")
+
+// Price is a price in whole euros given either as a number, e.g. 1234 or
+// 1234.0, or as a formatted string, e.g. "123 456 €". A price range is its
+// lower bound
+type Price int
+
+func (p *Price) UnmarshalJSON(b []byte) error {
+	var number json.Number
+	if json.Unmarshal(b, &number) == nil && number != "" {
+		value, err := number.Float64()
+		if err != nil {
+			return err
+		}
+		*p = Price(math.Round(value))
+		return nil
+	}
+
+	var formatted string
+	err := json.Unmarshal(b, &formatted)
+	if err != nil {
+		return errors.New("expected a number or a string")
+	}
+
+	price, err := parse.Euros(formatted)
+	if err != nil {
+		return err
+	}
+	*p = Price(price.Rounded())
+
+	return nil
+}
+
+// Building is where the listing is, as far as the card tells
+type Building struct {
+	Address  string `json:"address"`
+	District string `json:"district"`
+	City     string `json:"city"`
+}
+
+var postcodeReg = regexp.MustCompile(`\b[0-9]{5}\b`)
+
+// Postcode is the postcode of the address, if it has one
+func (b Building) Postcode() string {
+	return postcodeReg.FindString(b.Address)
+}
+
+// Coordinates is the location of a listing, both latitude and longitude are required
+type Coordinates struct {
+	Latitude  float64
+	Longitude float64
+}
+
+func (c *Coordinates) UnmarshalJSON(b []byte) error {
+	var coords struct {
+		Latitude  *float64 `json:"latitude"`
+		Longitude *float64 `json:"longitude"`
+	}
+	err := json.Unmarshal(b, &coords)
+	if err != nil {
+		return err
+	}
+
+	if coords.Latitude == nil {
+		return errors.New("latitude missing")
+	}
+	if coords.Longitude == nil {
+		return errors.New("longitude missing")
+	}
+
+	c.Latitude = *coords.Latitude
+	c.Longitude = *coords.Longitude
+
+	return nil
+}
+
+// cardID decodes only the id of a raw card, which is all that's needed before
+// the rest of it is derived from
+func cardID(raw json.RawMessage) (int, error) {
+	var card struct {
+		ID *int `json:"id"`
+	}
+	err := json.Unmarshal(raw, &card)
+	if err != nil {
+		return 0, fmt.Errorf("card field %q: %w", "id", err)
+	}
+	if card.ID == nil {
+		return 0, fmt.Errorf("card field %q: missing", "id")
+	}
+
+	return *card.ID, nil
+}
@@ -0,0 +1,70 @@
+package scraper
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPriceUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		json    string
+		want    Price
+		wantErr bool
+	}{
+		{`123456`, 123456, false},
+		{`1234.0`, 1234, false},
+		{`1234.5`, 1235, false},
+		{`1.5e5`, 150000, false},
+		{`"123 456 €"`, 123456, false},
+		{`"123 456,50 €"`, 123457, false},
+		{`"200 000 - 250 000 €"`, 200000, false},
+		{`"1234"`, 1234, false},
+		{`"Kysy hintaa"`, 0, true},
+		{`true`, 0, true},
+		{`{"amount": 1}`, 0, true},
+	}
+
+	for _, tt := range tests {
+		var p Price
+		err := json.Unmarshal([]byte(tt.json), &p)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Price %s error = %v, wantErr %v", tt.json, err, tt.wantErr)
+			continue
+		}
+		if p != tt.want {
+			t.Errorf("Price %s = %d, want %d", tt.json, p, tt.want)
+		}
+	}
+}
+
+func TestCardUnmarshalJSON(t *testing.T) {
+	var card Card
+	err := json.Unmarshal([]byte(`{"id": 1, "price": 1000.0, "size": 54.5, "rooms": null, "visits": 3,
+		"coordinates": {"latitude": 60.1, "longitude": 24.9},
+		"buildingData": {"address": "Testikatu 1, 00100 Helsinki", "city": "Helsinki"}}`), &card)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if card.ID != 1 || card.Price != 1000 || card.Size.Float64 != 54.5 || card.Rooms.Valid || card.Visits != 3 {
+		t.Errorf("card = %+v", card)
+	}
+	if card.Coordinates != (Coordinates{60.1, 24.9}) || card.Building.Postcode() != "00100" {
+		t.Errorf("card = %+v", card)
+	}
+
+	// Every field is attempted, the ones that failed are named
+	card = Card{}
+	err = json.Unmarshal([]byte(`{"id": 2, "price": "Kysy", "size": "iso", "coordinates": {"latitude": 60.1}}`), &card)
+	errs, ok := err.(ParseErrors)
+	if !ok {
+		t.Fatalf("error = %v, want ParseErrors", err)
+	}
+	for _, field := range []string{"card.price", "card.size", "card.visits", "card.coordinates"} {
+		if !errs.has(field) {
+			t.Errorf("errors %v miss %s", errs, field)
+		}
+	}
+	if len(errs) != 4 || card.ID != 2 {
+		t.Errorf("errors = %v, id = %d", errs, card.ID)
+	}
+}
@@ -0,0 +1,231 @@
+package scraper
+
+import (
+	"oikotie/database/models"
+	"oikotie/filter"
+	"oikotie/scraper/parse"
+	"regexp"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+)
+
+var constructionYearKeys = []string{"Rakennusvuosi", "Valmistumisvuosi"}
+var maintenanceFeeKeys = []string{"Hoitovastike"}
+var financingFeeKeys = []string{"Rahoitusvastike"}
+var debtShareKeys = []string{"Velkaosuus"}
+var debtFreePriceKeys = []string{"Velaton hinta"}
+var conditionKeys = []string{"Kunto", "Kuntoluokitus"}
+var energyClassKeys = []string{"Energialuokka"}
+var elevatorKeys = []string{"Hissi", "Taloyhtiössä on hissi"}
+var saunaKeys = []string{"Sauna", "Asunnossa sauna"}
+var balconyKeys = []string{"Parveke"}
+var totalFloorsKeys = []string{"Kerroksia", "Kerrosten lukumäärä", "Rakennuksen kerrosmäärä"}
+var lotOwnershipKeys = []string{"Tontin omistus", "Tontti"}
+var lotRentKeys = []string{"Tontin vuokra", "Tontin vuosivuokra"}
+
+var energyClassReg = regexp.MustCompile(`^([A-G])([^a-zA-Z]|$)`)
+
+var conditionNames = []struct {
+	name      string
+	condition filter.Condition
+}{
+	{"erinomainen", filter.Excellent},
+	{"hyvä", filter.Good},
+	{"tyydyttävä", filter.Satisfactory},
+	{"välttävä", filter.Passable},
+	{"huono", filter.Poor},
+}
+
+// lotOwnershipNames is checked in order, "valinnainen vuokratontti" also
+// contains "vuokra"
+var lotOwnershipNames = []struct {
+	name      string
+	ownership filter.LotOwnership
+}{
+	{"valinnainen", filter.OptionalRentLot},
+	{"vuokra", filter.RentedLot},
+	{"oma", filter.OwnLot},
+}
+
+// setDetailFields parses the structured fields of the detail page. The fields
+// are optional, a field is left null when the detail page doesn't have it
+func setDetailFields(listing *models.Listing, details listingDetails, errs ParseErrors) {
+	resetDetailFields(listing)
+
+	if value, ok := findDetail(details, constructionYearKeys...); ok {
+		year, err := parse.Int(value)
+		if err != nil {
+			errs.add("construction_year", err)
+		} else {
+			listing.ConstructionYear = null.IntFrom(year)
+		}
+	}
+
+	if value, ok := findDetail(details, maintenanceFeeKeys...); ok {
+		fee, err := parse.Euros(value)
+		if err != nil {
+			errs.add("maintenance_fee", err)
+		} else {
+			listing.MaintenanceFee = null.Float64From(fee.Monthly())
+		}
+	}
+
+	if value, ok := findDetail(details, financingFeeKeys...); ok {
+		fee, err := parse.Euros(value)
+		if err != nil {
+			errs.add("financing_fee", err)
+		} else {
+			listing.FinancingFee = null.Float64From(fee.Monthly())
+		}
+	}
+
+	if value, ok := findDetail(details, debtShareKeys...); ok {
+		debt, err := parse.Euros(value)
+		if err != nil {
+			errs.add("debt_share", err)
+		} else {
+			listing.DebtShare = null.IntFrom(debt.Rounded())
+		}
+	}
+
+	if value, ok := findDetail(details, debtFreePriceKeys...); ok {
+		price, err := parse.Euros(value)
+		if err != nil {
+			errs.add("debt_free_price", err)
+		} else {
+			listing.DebtFreePrice = null.IntFrom(price.Rounded())
+		}
+	}
+
+	if value, ok := findDetail(details, conditionKeys...); ok {
+		listing.Condition = parseCondition(value)
+	}
+
+	if value, ok := findDetail(details, energyClassKeys...); ok {
+		if m := energyClassReg.FindStringSubmatch(strings.TrimSpace(value)); m != nil {
+			listing.EnergyClass = null.StringFrom(m[1])
+		}
+	}
+
+	if value, ok := findDetail(details, elevatorKeys...); ok {
+		listing.HasElevator = yesNo(value)
+	}
+
+	if value, ok := findDetail(details, saunaKeys...); ok {
+		listing.HasSauna = parseSauna(value)
+	}
+
+	if value, ok := findDetail(details, balconyKeys...); ok {
+		// The balcony is often described instead, e.g. "Lasitettu parveke"
+		if yes := yesNo(value); yes.Valid {
+			listing.HasBalcony = yes
+		} else {
+			listing.HasBalcony = null.BoolFrom(strings.TrimSpace(value) != "")
+		}
+	}
+
+	if floors, ok := parseTotalFloors(details); ok {
+		listing.TotalFloors = null.IntFrom(floors)
+	}
+
+	if value, ok := findDetail(details, lotOwnershipKeys...); ok {
+		listing.LotOwnership = parseLotOwnership(value)
+	}
+
+	if value, ok := findDetail(details, lotRentKeys...); ok {
+		rent, err := parse.Euros(value)
+		if err != nil {
+			errs.add("lot_rent", err)
+		} else {
+			listing.LotRent = null.Float64From(rent.Yearly())
+		}
+	}
+}
+
+// resetDetailFields clears the fields set by setDetailFields, so that a reparse
+// doesn't keep values the details no longer have
+func resetDetailFields(listing *models.Listing) {
+	listing.ConstructionYear = null.Int{}
+	listing.MaintenanceFee = null.Float64{}
+	listing.FinancingFee = null.Float64{}
+	listing.DebtShare = null.Int{}
+	listing.DebtFreePrice = null.Int{}
+	listing.Condition = null.String{}
+	listing.EnergyClass = null.String{}
+	listing.HasElevator = null.Bool{}
+	listing.HasSauna = null.Bool{}
+	listing.HasBalcony = null.Bool{}
+	listing.TotalFloors = null.Int{}
+	listing.LotOwnership = null.String{}
+	listing.LotRent = null.Float64{}
+}
+
+// yesNo parses a Kyllä/Ei value, anything else is null
+func yesNo(value string) null.Bool {
+	yes, err := parse.YesNo(value)
+	if err != nil {
+		return null.Bool{}
+	}
+	return null.BoolFrom(yes)
+}
+
+// parseSauna reports whether the home has a sauna of its own, a sauna shared
+// by the housing company doesn't count
+func parseSauna(value string) null.Bool {
+	if yes := yesNo(value); yes.Valid {
+		return yes
+	}
+
+	lower := strings.ToLower(value)
+	switch {
+	case strings.Contains(lower, "oma"), strings.Contains(lower, "asunnossa"):
+		return null.BoolFrom(true)
+	case strings.Contains(lower, "taloyhtiö"), strings.Contains(lower, "yhteinen"):
+		return null.BoolFrom(false)
+	}
+
+	return null.Bool{}
+}
+
+func parseCondition(value string) null.String {
+	lower := strings.ToLower(value)
+	for _, c := range conditionNames {
+		if strings.Contains(lower, c.name) {
+			return null.StringFrom(string(c.condition))
+		}
+	}
+
+	return null.String{}
+}
+
+func parseLotOwnership(value string) null.String {
+	lower := strings.ToLower(value)
+	for _, o := range lotOwnershipNames {
+		if strings.Contains(lower, o.name) {
+			return null.StringFrom(string(o.ownership))
+		}
+	}
+
+	return null.String{}
+}
+
+// parseTotalFloors gets the floor count of the building, either given as is or
+// as the second half of the floor, e.g. "3/5"
+func parseTotalFloors(details listingDetails) (int, bool) {
+	if value, ok := findDetail(details, totalFloorsKeys...); ok {
+		floors, err := parse.Int(value)
+		if err == nil {
+			return floors, true
+		}
+	}
+
+	if value, ok := findDetail(details, "Kerros"); ok {
+		_, floors, err := parse.Floor(value)
+		if err == nil && floors > 0 {
+			return floors, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,244 @@
+package scraper
+
+import (
+	"oikotie/database/models"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/null/v8"
+)
+
+// apartmentDetails is the detail page of an apartment as scraped, section by section
+var apartmentDetails = listingDetails{
+	"Perustiedot": {
+		"Sijainti":           "Testikatu 1 A 5, 00100 Helsinki",
+		"Kerros":             "3/5",
+		"Asuinpinta-ala":     "54,5 m²",
+		"Rakennuksen tyyppi": "Kerrostalo",
+		"Rakennusvuosi":      "1975",
+		"Kunto":              "Hyvä",
+		"Hissi":              "Kyllä",
+		"Asunnossa sauna":    "Ei",
+		"Parveke":            "Lasitettu parveke",
+	},
+	"Hinta ja kustannukset": {
+		"Velaton hinta":   "250 000 €",
+		"Myyntihinta":     "210 000 €",
+		"Velkaosuus":      "40 000,50 €",
+		"Hoitovastike":    "250,50 € / kk",
+		"Rahoitusvastike": "1 200 € / vuosi",
+	},
+	"Talon ja tontin tiedot": {
+		"Energialuokka":  "C2013",
+		"Tontin omistus": "Oma",
+	},
+}
+
+func TestSetDetailFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		details listingDetails
+		want    models.Listing
+		errs    []string
+	}{
+		{
+			name:    "apartment",
+			details: apartmentDetails,
+			want: models.Listing{
+				ConstructionYear: null.IntFrom(1975),
+				MaintenanceFee:   null.Float64From(250.5),
+				FinancingFee:     null.Float64From(100),
+				DebtShare:        null.IntFrom(40001),
+				DebtFreePrice:    null.IntFrom(250000),
+				Condition:        null.StringFrom("good"),
+				EnergyClass:      null.StringFrom("C"),
+				HasElevator:      null.BoolFrom(true),
+				HasSauna:         null.BoolFrom(false),
+				HasBalcony:       null.BoolFrom(true),
+				TotalFloors:      null.IntFrom(5),
+				LotOwnership:     null.StringFrom("own"),
+			},
+		},
+		{
+			name:    "no details",
+			details: listingDetails{},
+		},
+		{
+			name: "house with alternative keys",
+			details: listingDetails{
+				"Perustiedot": {
+					"Valmistumisvuosi":      "2015",
+					"Kuntoluokitus":         "Erinomainen",
+					"Taloyhtiössä on hissi": "ei",
+					"Sauna":                 "Oma sauna",
+					"Parveke":               "Ei",
+					"Kerrosten lukumäärä":   "2",
+				},
+				"Tontti": {
+					"Tontti":             "Valinnainen vuokratontti",
+					"Tontin vuosivuokra": "1 500 €",
+				},
+			},
+			want: models.Listing{
+				ConstructionYear: null.IntFrom(2015),
+				Condition:        null.StringFrom("excellent"),
+				HasElevator:      null.BoolFrom(false),
+				HasSauna:         null.BoolFrom(true),
+				HasBalcony:       null.BoolFrom(false),
+				TotalFloors:      null.IntFrom(2),
+				LotOwnership:     null.StringFrom("optionalRent"),
+				LotRent:          null.Float64From(1500),
+			},
+		},
+		{
+			name: "monthly lot rent is yearly",
+			details: listingDetails{
+				"Tontti": {"Tontin vuokra": "125 € / kk", "Tontin omistus": "Vuokralla"},
+			},
+			want: models.Listing{
+				LotOwnership: null.StringFrom("rented"),
+				LotRent:      null.Float64From(1500),
+			},
+		},
+		{
+			name: "odd formats",
+			details: listingDetails{
+				"Perustiedot": {
+					"Rakennusvuosi":   "1975-1976",
+					"Kunto":           "Ei tiedossa",
+					"Energialuokka":   "Ei lain edellyttämää energiatodistusta",
+					"Hissi":           "Tarkista",
+					"Asunnossa sauna": "Taloyhtiössä sauna",
+					"Kerros":          "Katutaso",
+					"Kerroksia":       "noin",
+				},
+			},
+			want: models.Listing{
+				ConstructionYear: null.IntFrom(1975),
+				HasSauna:         null.BoolFrom(false),
+			},
+		},
+		{
+			name: "unparseable values",
+			details: listingDetails{
+				"Perustiedot": {
+					"Rakennusvuosi":   "Ei tiedossa",
+					"Hoitovastike":    "Kysy välittäjältä",
+					"Rahoitusvastike": "-",
+					"Velkaosuus":      "",
+					"Velaton hinta":   "Tarjousten mukaan",
+					"Tontin vuokra":   "sis. vastikkeeseen",
+				},
+			},
+			errs: []string{"construction_year", "debt_free_price", "debt_share", "financing_fee", "lot_rent", "maintenance_fee"},
+		},
+	}
+
+	for _, tt := range tests {
+		// Fields of a previous parse are cleared
+		listing := models.Listing{ConstructionYear: null.IntFrom(1900), LotRent: null.Float64From(1)}
+		errs := ParseErrors{}
+		setDetailFields(&listing, tt.details, errs)
+
+		if !reflect.DeepEqual(listing, tt.want) {
+			t.Errorf("%s: got\n%+v\nwant\n%+v", tt.name, listing, tt.want)
+		}
+
+		got := []string{}
+		for field := range errs {
+			got = append(got, field)
+		}
+		if len(got) != len(tt.errs) {
+			t.Errorf("%s: parse errors %v, want %v", tt.name, errs, tt.errs)
+			continue
+		}
+		for _, field := range tt.errs {
+			if !errs.has(field) {
+				t.Errorf("%s: parse errors %v, want %v", tt.name, errs, tt.errs)
+				break
+			}
+		}
+	}
+}
+
+func TestPropertyTypeOf(t *testing.T) {
+	tests := []struct {
+		kind    string
+		subType null.Int
+		details listingDetails
+		want    PropertyType
+	}{
+		{"sale", null.Int{}, apartmentDetails, Apartment},
+		{"sale", null.Int{}, listingDetails{"Perustiedot": {"Rakennuksen tyyppi": "Rivitalo"}}, RowHouse},
+		{"sale", null.Int{}, listingDetails{"Perustiedot": {"Talotyyppi": "Paritalo"}}, SemiDetachedHouse},
+		{"sale", null.Int{}, listingDetails{"Perustiedot": {"Rakennuksen tyyppi": "Omakotitalo, 2 krs"}}, DetachedHouse},
+		// The detail page decides over the card
+		{"sale", null.IntFrom(4), listingDetails{"Perustiedot": {"Rakennuksen tyyppi": "Luhtitalo"}}, Apartment},
+		{"sale", null.IntFrom(2), listingDetails{}, RowHouse},
+		{"sale", null.IntFrom(99), listingDetails{"Perustiedot": {"Rakennuksen tyyppi": "Muu"}}, Apartment},
+		{"plot", null.IntFrom(2), listingDetails{"Perustiedot": {"Rakennuksen tyyppi": "Rivitalo"}}, Plot},
+	}
+
+	for _, tt := range tests {
+		got := propertyTypeOf(tt.kind, Card{SubType: tt.subType}, tt.details)
+		if got != tt.want {
+			t.Errorf("propertyTypeOf(%s, %v, %v) = %s, want %s", tt.kind, tt.subType, tt.details, got, tt.want)
+		}
+	}
+}
+
+func TestSetRentFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		price   int
+		details listingDetails
+		want    models.Listing
+		errs    []string
+	}{
+		{
+			name:    "rent from the card",
+			price:   900,
+			details: listingDetails{"Vuokra": {"Vakuus": "2 kk vuokra", "Vuokrasopimuksen kesto": "Toistaiseksi voimassa oleva"}},
+			want:    models.Listing{Price: 900, MonthlyRent: null.IntFrom(900), Deposit: null.IntFrom(1800)},
+		},
+		{
+			name:    "rent from the details",
+			price:   900,
+			details: listingDetails{"Vuokra": {"Vuokra/kk": "950 € / kk", "Vuokravakuus": "1 900 €", "Vähimmäisvuokra-aika": "1 vuosi"}},
+			want:    models.Listing{Price: 900, MonthlyRent: null.IntFrom(950), Deposit: null.IntFrom(1900), MinLeaseMonths: null.IntFrom(12)},
+		},
+		{
+			name:    "no deposit",
+			price:   700,
+			details: listingDetails{"Vuokra": {"Vakuus": "Ei vakuutta", "Vuokra-aika": "6 kk"}},
+			want:    models.Listing{Price: 700, MonthlyRent: null.IntFrom(700), Deposit: null.IntFrom(0), MinLeaseMonths: null.IntFrom(6)},
+		},
+		{
+			name:    "unparseable",
+			price:   700,
+			details: listingDetails{"Vuokra": {"Vuokra": "Kysy", "Vakuus": "sopimuksen mukaan"}},
+			want:    models.Listing{Price: 700, MonthlyRent: null.IntFrom(700)},
+			errs:    []string{"deposit", "monthly_rent"},
+		},
+	}
+
+	for _, tt := range tests {
+		listing := models.Listing{Price: tt.price}
+		errs := ParseErrors{}
+		setRentFields(&listing, tt.details, errs)
+
+		if !reflect.DeepEqual(listing, tt.want) {
+			t.Errorf("%s: got\n%+v\nwant\n%+v", tt.name, listing, tt.want)
+		}
+		if len(errs) != len(tt.errs) {
+			t.Errorf("%s: parse errors %v, want %v", tt.name, errs, tt.errs)
+			continue
+		}
+		for _, field := range tt.errs {
+			if !errs.has(field) {
+				t.Errorf("%s: parse errors %v, want %v", tt.name, errs, tt.errs)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package scraper
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+)
+
+// ParseErrors are the fields of a listing that failed to parse, mapped to the
+// reason. Card fields are prefixed with "card."
+type ParseErrors map[string]string
+
+func (e ParseErrors) add(field string, err error) {
+	e[field] = err.Error()
+}
+
+func (e ParseErrors) has(field string) bool {
+	_, ok := e[field]
+	return ok
+}
+
+func (e ParseErrors) Error() string {
+	fields := make([]string, 0, len(e))
+	for field := range e {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for i, field := range fields {
+		fields[i] = field + ": " + e[field]
+	}
+
+	return strings.Join(fields, "; ")
+}
+
+// orNil is the errors as an error, nil when there are none
+func (e ParseErrors) orNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// JSON is the errors for the parse_errors column, null when there are none
+func (e ParseErrors) JSON() null.JSON {
+	var j null.JSON
+	if len(e) > 0 {
+		_ = j.Marshal(e)
+	}
+	return j
+}
@@ -0,0 +1,158 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// FetcherOptions configures the HTTP client every scraper request goes through
+type FetcherOptions struct {
+	RetryMax     int
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+	// RetryAfterMax is the longest Retry-After wait honoured, a request asked
+	// to wait longer fails instead of retrying early
+	RetryAfterMax time.Duration
+	// Timeout limits a single attempt of a request, 0 means no timeout
+	Timeout   time.Duration
+	UserAgent string
+	// RequestsPerSecond limits the request rate per host, 0 disables the limit
+	RequestsPerSecond float64
+	// Transport makes the actual requests, http.DefaultTransport is used if nil
+	Transport http.RoundTripper
+}
+
+// DefaultFetcherOptions returns the options used unless configured otherwise
+func DefaultFetcherOptions() FetcherOptions {
+	return FetcherOptions{
+		RetryMax:          5,
+		RetryWaitMin:      10 * time.Second,
+		RetryWaitMax:      5 * time.Minute,
+		RetryAfterMax:     30 * time.Minute,
+		Timeout:           30 * time.Second,
+		UserAgent:         "oikotie-scraper (+https://github.com/jhnj/oikotie)",
+		RequestsPerSecond: 2,
+	}
+}
+
+type fetcher struct {
+	client    *http.Client
+	userAgent string
+}
+
+func newFetcher(o FetcherOptions) *fetcher {
+	transport := o.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.HTTPClient = &http.Client{
+		Timeout: o.Timeout,
+		Transport: &limitedTransport{
+			next:    transport,
+			limiter: newHostLimiter(o.RequestsPerSecond),
+		},
+	}
+	retryClient.RetryMax = o.RetryMax
+	retryClient.RetryWaitMin = o.RetryWaitMin
+	retryClient.RetryWaitMax = o.RetryWaitMax
+	retryClient.CheckRetry = retryAfterPolicy(o.RetryAfterMax)
+	retryClient.Backoff = retryAfterBackoff
+
+	return &fetcher{
+		client:    retryClient.StandardClient(),
+		userAgent: o.UserAgent,
+	}
+}
+
+func (f *fetcher) Do(req *http.Request) (*http.Response, error) {
+	if f.userAgent != "" {
+		req.Header.Set("User-Agent", f.userAgent)
+	}
+
+	return f.client.Do(req)
+}
+
+func (f *fetcher) Get(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Do(req)
+}
+
+// limitedTransport waits for the host's rate limit before every attempt,
+// including retries
+type limitedTransport struct {
+	next    http.RoundTripper
+	limiter *hostLimiter
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	err := t.limiter.Wait(req.Context(), req.URL.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// retryAfterPolicy retries like retryablehttp's default policy, but gives up
+// when a response asks to wait longer than max
+func retryAfterPolicy(max time.Duration) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if wait, ok := retryAfter(resp); ok && wait > max {
+			return false, fmt.Errorf("Server asked to retry after %s, more than the %s allowed", wait.Round(time.Second), max)
+		}
+
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
+}
+
+// retryAfterBackoff honors the Retry-After header of 429 and 503 responses,
+// which retryAfterPolicy has checked to be short enough, and otherwise backs
+// off exponentially up to max
+func retryAfterBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if wait, ok := retryAfter(resp); ok {
+		return wait
+	}
+
+	return retryablehttp.DefaultBackoff(min, max, attemptNum, nil)
+}
+
+// retryAfter is the Retry-After wait of a 429 or 503 response
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil || (resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable) {
+		return 0, false
+	}
+
+	return parseRetryAfter(resp.Header.Get("Retry-After"))
+}
+
+// parseRetryAfter parses a Retry-After value given either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		wait := time.Until(at)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
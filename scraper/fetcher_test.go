@@ -0,0 +1,101 @@
+package scraper
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"120", 2 * time.Minute, true},
+		{"0", 0, true},
+		{time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0, true},
+		{"", 0, false},
+		{"soon", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseRetryAfter(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	at := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	got, ok := parseRetryAfter(at)
+	if !ok || got < 59*time.Minute || got > time.Hour {
+		t.Errorf("parseRetryAfter(%q) = %v, %v, want about an hour", at, got, ok)
+	}
+}
+
+func TestRetryAfterBackoff(t *testing.T) {
+	throttled := func(status int, retryAfter string) *http.Response {
+		return &http.Response{StatusCode: status, Header: http.Header{"Retry-After": {retryAfter}}}
+	}
+
+	tests := []struct {
+		name string
+		resp *http.Response
+		want time.Duration
+	}{
+		// Longer than the backoff max, shorter than RetryAfterMax
+		{"too many requests", throttled(http.StatusTooManyRequests, "600"), 10 * time.Minute},
+		{"unavailable", throttled(http.StatusServiceUnavailable, "30"), 30 * time.Second},
+		{"other status", throttled(http.StatusInternalServerError, "600"), 2 * time.Second},
+		{"no response", nil, 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		got := retryAfterBackoff(time.Second, 5*time.Minute, 1, tt.resp)
+		if got != tt.want {
+			t.Errorf("%s: backoff %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFetcherRetryAfterMax(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Retry-After", r.URL.Query().Get("wait"))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	o := DefaultFetcherOptions()
+	o.RequestsPerSecond = 0
+	o.RetryAfterMax = time.Minute
+	f := newFetcher(o)
+
+	// A short wait is honoured
+	resp, err := f.Get(context.Background(), server.URL+"?wait=0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || atomic.LoadInt32(&requests) != 2 {
+		t.Errorf("got %d after %d requests, want 200 after 2", resp.StatusCode, requests)
+	}
+
+	// A longer one gives up without retrying
+	atomic.StoreInt32(&requests, 0)
+	_, err = f.Get(context.Background(), server.URL+"?wait=3600")
+	if err == nil || !strings.Contains(err.Error(), "retry after 1h0m0s, more than the 1m0s allowed") {
+		t.Errorf("error = %v, want a too long Retry-After", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("made %d requests, want 1", n)
+	}
+}
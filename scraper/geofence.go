@@ -0,0 +1,86 @@
+package scraper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"oikotie/database/models"
+	"oikotie/geo"
+
+	"github.com/volatiletech/null/v8"
+)
+
+// addGeofenceAreas stores the configured geofences and adds the postcode areas
+// covering them to the areas to scrape. The covering areas that aren't
+// scraped anyway are marked fenced, their listings are kept only when inside
+// a geofence
+func (s *Scraper) addGeofenceAreas(ctx context.Context, areas []*models.Area) ([]*models.Area, error) {
+	s.geofenceIDs = nil
+	s.fencedAreas = map[int]bool{}
+	if len(s.options.Geofences) == 0 {
+		return areas, nil
+	}
+
+	ids, err := geo.SaveGeofences(ctx, s.db, s.options.Geofences)
+	if err != nil {
+		return nil, err
+	}
+	s.geofenceIDs = ids
+
+	scraped := map[int]bool{}
+	for _, area := range areas {
+		scraped[area.ID] = true
+	}
+
+	for i, fence := range s.options.Geofences {
+		covers, err := geo.CoveringBoundaries(ctx, s.db, ids[i], postcodeCard)
+		if err != nil {
+			return nil, err
+		}
+		if len(covers) == 0 {
+			return nil, fmt.Errorf("No postcode boundaries overlap geofence %q, import them with ot geo import", fence.Name)
+		}
+
+		for _, cover := range covers {
+			var area *models.Area
+			if cover.AreaID.Valid {
+				area, err = models.FindArea(ctx, s.db, cover.AreaID.Int)
+			} else {
+				area, err = s.resolveArea(ctx, AreaQuery{Query: cover.Code})
+			}
+			if err != nil {
+				return nil, fmt.Errorf("Geofence %q, postcode %s: %w", fence.Name, cover.Code, err)
+			}
+
+			if !scraped[area.ID] {
+				scraped[area.ID] = true
+				s.fencedAreas[area.ID] = true
+				areas = append(areas, area)
+			}
+		}
+	}
+
+	return areas, nil
+}
+
+// fence records the first geofence the listing is in. Listings of fenced
+// areas outside all geofences are dropped
+func (s *Scraper) fence(ctx context.Context, job *listingJob) (bool, error) {
+	if len(s.geofenceIDs) == 0 {
+		return true, nil
+	}
+
+	var card Card
+	_ = json.Unmarshal(job.listing.ListingData.JSON, &card)
+
+	job.listing.GeofenceID = null.Int{}
+	if card.Coordinates != (Coordinates{}) {
+		id, err := geo.GeofenceAt(ctx, s.db, s.geofenceIDs, card.Coordinates.Latitude, card.Coordinates.Longitude)
+		if err != nil {
+			return false, err
+		}
+		job.listing.GeofenceID = id
+	}
+
+	return job.listing.GeofenceID.Valid || !s.fencedAreas[job.area.ID], nil
+}
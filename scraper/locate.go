@@ -0,0 +1,259 @@
+package scraper
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"oikotie/database/models"
+	"oikotie/geo"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// locate links the listing to the most specific area its card places it in.
+// An imported boundary containing its coordinates decides first, as searches
+// also return listings of neighbouring areas. Otherwise the municipality,
+// district and postcode below the searched area are resolved and stored as
+// its descendants, listings stay in the searched area when their card doesn't
+// tell or the location search has no single match
+func (s *Scraper) locate(ctx context.Context, job *listingJob) (bool, error) {
+	var card Card
+	// Decoding errors are recorded when deriving the fields, a card that
+	// failed to decode has what could be decoded
+	_ = json.Unmarshal(job.listing.ListingData.JSON, &card)
+
+	if card.Coordinates != (Coordinates{}) {
+		area, err := s.boundaryArea(ctx, card.Coordinates)
+		if err != nil {
+			return false, err
+		}
+		if area != nil {
+			job.area = area
+			job.listing.AreaID = area.ID
+			return true, nil
+		}
+	}
+
+	b := card.Building
+	city := b.City
+	if city == "" {
+		city = job.area.City
+	}
+
+	district := ""
+	if b.District != "" {
+		district = b.District + ", " + city
+	}
+
+	// Only regions and municipalities contain the areas below them, districts
+	// and postcodes overlap
+	area := job.area
+	var container *models.Area
+	if containsAreas(area.CardType) {
+		container = area
+	}
+
+	for _, level := range []struct {
+		cardType int
+		query    string
+	}{
+		{municipalityCard, b.City},
+		{districtCard, district},
+		{postcodeCard, b.Postcode()},
+	} {
+		if level.query == "" || cardLevels[level.cardType] <= cardLevels[area.CardType] {
+			continue
+		}
+
+		child, err := s.childArea(ctx, container, level.cardType, level.query)
+		if err != nil {
+			return false, err
+		}
+		if child != nil {
+			area = child
+			if containsAreas(child.CardType) {
+				container = child
+			}
+		}
+	}
+
+	job.area = area
+	job.listing.AreaID = area.ID
+
+	return true, nil
+}
+
+// childArea finds or resolves the area of the given card type for the query,
+// storing it below parent when there's one. Areas that can't be resolved are nil, the results
+// are cached for the run
+func (s *Scraper) childArea(ctx context.Context, parent *models.Area, cardType int, query string) (*models.Area, error) {
+	s.areaCacheMu.Lock()
+	defer s.areaCacheMu.Unlock()
+
+	key := fmt.Sprintf("%d:%s", cardType, strings.ToLower(query))
+	if area, ok := s.areaCache[key]; ok {
+		return area, nil
+	}
+
+	area, err := s.findChildArea(ctx, parent, cardType, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.areaCache == nil {
+		s.areaCache = map[string]*models.Area{}
+	}
+	s.areaCache[key] = area
+
+	return area, nil
+}
+
+// boundaryArea finds the area whose imported boundary contains the point, nil
+// when there is none
+func (s *Scraper) boundaryArea(ctx context.Context, c Coordinates) (*models.Area, error) {
+	id, err := geo.AreaAt(ctx, s.db, c.Latitude, c.Longitude)
+	if err != nil || !id.Valid {
+		return nil, err
+	}
+
+	s.areaCacheMu.Lock()
+	defer s.areaCacheMu.Unlock()
+
+	key := fmt.Sprintf("id:%d", id.Int)
+	if area, ok := s.areaCache[key]; ok {
+		return area, nil
+	}
+
+	area, err := models.FindArea(ctx, s.db, id.Int)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.areaCache == nil {
+		s.areaCache = map[string]*models.Area{}
+	}
+	s.areaCache[key] = area
+
+	return area, nil
+}
+
+func (s *Scraper) findChildArea(ctx context.Context, parent *models.Area, cardType int, query string) (*models.Area, error) {
+	area, err := models.Areas(
+		models.AreaWhere.Query.EQ(null.StringFrom(query)),
+		models.AreaWhere.CardType.EQ(cardType),
+	).One(ctx, s.db)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	if area == nil {
+		matching, err := s.searchLocations(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+
+		found, ok := childCandidate(matching, cardType, query)
+		if !ok {
+			return nil, nil
+		}
+
+		area, err = models.Areas(models.AreaWhere.ExternalID.EQ(found.Card.CardID)).One(ctx, s.db)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+
+		if area == nil {
+			area = newArea(found)
+
+			// The query is kept if it's free, it may be the configured query of another area
+			taken, err := models.Areas(models.AreaWhere.Query.EQ(null.StringFrom(query))).Exists(ctx, s.db)
+			if err != nil {
+				return nil, err
+			}
+			if !taken {
+				area.Query = null.StringFrom(query)
+			}
+
+			if parent != nil {
+				area.ParentID = null.IntFrom(parent.ID)
+			}
+			err = area.Insert(ctx, s.db, boil.Infer())
+			if err != nil {
+				return nil, err
+			}
+			return area, nil
+		}
+	}
+
+	if parent != nil && !area.ParentID.Valid && area.ID != parent.ID {
+		area.ParentID = null.IntFrom(parent.ID)
+		_, err = area.Update(ctx, s.db, boil.Whitelist(models.AreaColumns.ParentID))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return area, nil
+}
+
+// childCandidate picks the only card of the type, or the only one of them
+// named like the query, e.g. "Kallio" for "Kallio, Helsinki"
+func childCandidate(matching []apiArea, cardType int, query string) (apiArea, bool) {
+	candidates := []apiArea{}
+	for _, area := range matching {
+		if area.Card.CardType == cardType {
+			candidates = append(candidates, area)
+		}
+	}
+
+	if len(candidates) > 1 {
+		name := strings.ToLower(strings.TrimSpace(strings.Split(query, ",")[0]))
+		named := []apiArea{}
+		for _, area := range candidates {
+			if strings.HasPrefix(strings.ToLower(area.Card.Name), name) {
+				named = append(named, area)
+			}
+		}
+		candidates = named
+	}
+
+	if len(candidates) != 1 {
+		return apiArea{}, false
+	}
+	return candidates[0], true
+}
+
+// containsAreas reports whether areas of the card type contain the more
+// specific areas within them
+func containsAreas(cardType int) bool {
+	level, ok := cardLevels[cardType]
+	return ok && level <= cardLevels[municipalityCard]
+}
+
+// DescendantAreaIDs returns the id of the area and the ids of all areas below it
+func DescendantAreaIDs(ctx context.Context, db boil.ContextExecutor, id int) ([]int, error) {
+	var rows []struct {
+		ID int `boil:"id"`
+	}
+	err := queries.Raw(`
+		WITH RECURSIVE descendants(id) AS (
+			SELECT $1::int
+			UNION
+			SELECT areas.id FROM areas JOIN descendants ON areas.parent_id = descendants.id
+		)
+		SELECT id FROM descendants`, id).Bind(ctx, db, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,41 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"oikotie/database/models"
+	"oikotie/geo"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// ListingNear is a listing with its distance from a point in meters
+type ListingNear struct {
+	models.Listing `boil:",bind"`
+	Distance       float64 `boil:"distance"`
+}
+
+// ListingsNear returns the listings within the given meters of the point,
+// nearest first. The mods narrow the listings further, e.g. to active ones
+func ListingsNear(ctx context.Context, db boil.ContextExecutor, p geo.Point, within float64, mods ...qm.QueryMod) ([]ListingNear, error) {
+	coord := models.TableNames.Listings + "." + models.ListingColumns.Coord
+
+	// The point is bound once in a CTE, as selected columns take no arguments
+	mods = append([]qm.QueryMod{
+		qm.With("origin AS (SELECT ST_SetSRID(ST_MakePoint(?::float8, ?::float8), ?::int)::geography AS point)",
+			p.Longitude, p.Latitude, geo.WGS84),
+		qm.InnerJoin("origin ON TRUE"),
+		qm.Select(models.TableNames.Listings+".*", fmt.Sprintf("ST_Distance(%s, origin.point) AS distance", coord)),
+		qm.Where(fmt.Sprintf("ST_DWithin(%s, origin.point, ?)", coord), within),
+		qm.OrderBy("distance, " + models.TableNames.Listings + "." + models.ListingColumns.ID),
+	}, mods...)
+
+	var listings []ListingNear
+	err := models.Listings(mods...).Bind(ctx, db, &listings)
+	if err != nil {
+		return nil, err
+	}
+
+	return listings, nil
+}
@@ -0,0 +1,218 @@
+// Package parse parses the Finnish formatted values of Oikotie listings:
+// numbers with a decimal comma and spaces between thousands, money, areas,
+// floors, yes/no values and dates
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Period is what a sum of money is paid for, e.g. "€/kk"
+type Period string
+
+const (
+	Once    Period = ""
+	Monthly Period = "month"
+	Yearly  Period = "year"
+)
+
+// Money is a sum of euros
+type Money struct {
+	Amount float64
+	Period Period
+}
+
+// Yearly is the amount per year, a one-off amount as is
+func (m Money) Yearly() float64 {
+	if m.Period == Monthly {
+		return m.Amount * 12
+	}
+	return m.Amount
+}
+
+// Monthly is the amount per month, a one-off amount as is
+func (m Money) Monthly() float64 {
+	if m.Period == Yearly {
+		return m.Amount / 12
+	}
+	return m.Amount
+}
+
+// Rounded is the amount rounded to whole euros
+func (m Money) Rounded() int {
+	return int(math.Round(m.Amount))
+}
+
+// ErrNoNumber is returned when a value has no number to parse
+var ErrNoNumber = errors.New("no number")
+
+// A number may have spaces, including non-breaking and narrow ones, between
+// thousands. The space is only a separator when followed by three digits, so
+// "3 5" isn't 35
+var numberReg = regexp.MustCompile(`[0-9]{1,3}(?:[ \x{00a0}\x{202f}][0-9]{3})+(?:,[0-9]+)?|[0-9]+(?:,[0-9]+)?`)
+var rangeReg = regexp.MustCompile(`^\s*[-–—]\s*`)
+var monthlyReg = regexp.MustCompile(`(?i)(/|per|\b)\s*(kk|kuukausi|kuukaudessa|kuussa|month)\b`)
+var yearlyReg = regexp.MustCompile(`(?i)(/|per|\b)\s*(v|vuosi|vuodessa|year)\b`)
+var hectaresReg = regexp.MustCompile(`(?i)^\s*(?:ha|hehtaari\p{L}*)(?:[^\p{L}]|$)`)
+var floorReg = regexp.MustCompile(`^\s*(-?[0-9]+)\s*(?:/\s*([0-9]+))?`)
+
+var separators = strings.NewReplacer(" ", "", " ", "", " ", "", ",", ".")
+
+// Decimal parses the first number of a value, e.g. "54,5" or "1 234,50 €"
+func Decimal(value string) (float64, error) {
+	number := numberReg.FindString(value)
+	if number == "" {
+		return 0, fmt.Errorf("%w in %q", ErrNoNumber, value)
+	}
+
+	return strconv.ParseFloat(separators.Replace(number), 64)
+}
+
+// Int parses the first number of a value as an integer, e.g. "5 kerrosta"
+func Int(value string) (int, error) {
+	number, err := Decimal(value)
+	if err != nil {
+		return 0, err
+	}
+	if number != math.Trunc(number) {
+		return 0, fmt.Errorf("%q is not an integer", value)
+	}
+
+	return int(number), nil
+}
+
+// Euros parses a sum of euros, e.g. "123 456,50 €" or "250 € / kk". A range
+// is parsed as its lower bound
+func Euros(value string) (Money, error) {
+	low, _, err := EurosRange(value)
+	return low, err
+}
+
+// EurosRange parses a range of euros, e.g. "200 000 - 250 000 €". A single
+// sum is both the lower and the upper bound
+func EurosRange(value string) (Money, Money, error) {
+	loc := numberReg.FindStringIndex(value)
+	if loc == nil {
+		return Money{}, Money{}, fmt.Errorf("%w in %q", ErrNoNumber, value)
+	}
+
+	period := periodOf(value)
+	low, err := Decimal(value[loc[0]:loc[1]])
+	if err != nil {
+		return Money{}, Money{}, err
+	}
+
+	high := low
+	rest := value[loc[1]:]
+	if sep := rangeReg.FindString(strings.TrimLeft(rest, " €")); sep != "" {
+		high, err = Decimal(rest)
+		if err != nil {
+			return Money{}, Money{}, err
+		}
+	}
+
+	return Money{low, period}, Money{high, period}, nil
+}
+
+func periodOf(value string) Period {
+	switch {
+	case monthlyReg.MatchString(value):
+		return Monthly
+	case yearlyReg.MatchString(value):
+		return Yearly
+	}
+	return Once
+}
+
+// Area parses an area into square meters, e.g. "54,5 m²", or "0,5 ha" for
+// hectares. Only the unit right after the number counts, so the "ha" of
+// "1 200 m², hallinta-osuus" isn't hectares
+func Area(value string) (float64, error) {
+	loc := numberReg.FindStringIndex(value)
+	if loc == nil {
+		return 0, fmt.Errorf("%w in %q", ErrNoNumber, value)
+	}
+
+	area, err := Decimal(value[loc[0]:loc[1]])
+	if err != nil {
+		return 0, err
+	}
+
+	if hectaresReg.MatchString(value[loc[1]:]) {
+		area *= 10000
+	}
+
+	return area, nil
+}
+
+// Floor parses a floor, e.g. "3", or a floor out of the floor count of the
+// building, e.g. "3/5". The total is 0 when not given
+func Floor(value string) (floor int, total int, err error) {
+	m := floorReg.FindStringSubmatch(value)
+	if m == nil {
+		return 0, 0, fmt.Errorf("%w in %q", ErrNoNumber, value)
+	}
+
+	floor, err = strconv.Atoi(m[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if m[2] != "" {
+		total, err = strconv.Atoi(m[2])
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return floor, total, nil
+}
+
+// YesNo parses a Kyllä/Ei value
+func YesNo(value string) (bool, error) {
+	words := strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	if len(words) > 0 {
+		switch words[0] {
+		case "kyllä", "on":
+			return true, nil
+		case "ei":
+			return false, nil
+		}
+	}
+
+	return false, fmt.Errorf("%q is neither Kyllä nor Ei", value)
+}
+
+var dateLayouts = []string{"2.1.2006", "2.1.06", "2006-01-02"}
+
+// Date parses a Finnish date, e.g. "1.2.2021", in Finnish time
+func Date(value string) (time.Time, error) {
+	value = strings.TrimSuffix(strings.TrimSpace(value), ".")
+	for _, layout := range dateLayouts {
+		t, err := time.ParseInLocation(layout, value, finland)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%q is not a date", value)
+}
+
+var finland = loadFinland()
+
+func loadFinland() *time.Location {
+	loc, err := time.LoadLocation("Europe/Helsinki")
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
@@ -0,0 +1,229 @@
+package parse
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		value string
+		want  float64
+	}{
+		{"54", 54},
+		{"54,5", 54.5},
+		{"54,5 m²", 54.5},
+		{"1 234,50 €", 1234.5},
+		{"123 456 €", 123456},
+		{"123 456 €", 123456},
+		{"123 456,75 €", 123456.75},
+		{"1 234 567", 1234567},
+		{"noin 3 5", 3},
+		{"Rakennettu 1975", 1975},
+	}
+
+	for _, tt := range tests {
+		got, err := Decimal(tt.value)
+		if err != nil {
+			t.Errorf("Decimal(%q): %v", tt.value, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Decimal(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalNoNumber(t *testing.T) {
+	for _, value := range []string{"", "Ei tiedossa", "€ / kk"} {
+		_, err := Decimal(value)
+		if !errors.Is(err, ErrNoNumber) {
+			t.Errorf("Decimal(%q) error = %v, want ErrNoNumber", value, err)
+		}
+	}
+}
+
+func TestInt(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{"5 kerrosta", 5, false},
+		{"1 200", 1200, false},
+		{"2,0", 2, false},
+		{"2,5", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := Int(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Int(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Int(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestEurosRange(t *testing.T) {
+	tests := []struct {
+		value     string
+		low, high Money
+	}{
+		{"123 456,50 €", Money{123456.5, Once}, Money{123456.5, Once}},
+		{"250 € / kk", Money{250, Monthly}, Money{250, Monthly}},
+		{"250 €/kk", Money{250, Monthly}, Money{250, Monthly}},
+		{"1 200 € / vuosi", Money{1200, Yearly}, Money{1200, Yearly}},
+		{"3,50 €/m²/kk", Money{3.5, Monthly}, Money{3.5, Monthly}},
+		{"200 000 - 250 000 €", Money{200000, Once}, Money{250000, Once}},
+		{"200 000 € – 250 000 €", Money{200000, Once}, Money{250000, Once}},
+	}
+
+	for _, tt := range tests {
+		low, high, err := EurosRange(tt.value)
+		if err != nil {
+			t.Errorf("EurosRange(%q): %v", tt.value, err)
+			continue
+		}
+		if low != tt.low || high != tt.high {
+			t.Errorf("EurosRange(%q) = %v, %v, want %v, %v", tt.value, low, high, tt.low, tt.high)
+		}
+	}
+
+	_, _, err := EurosRange("Kysy hintaa")
+	if !errors.Is(err, ErrNoNumber) {
+		t.Errorf("EurosRange without a number error = %v, want ErrNoNumber", err)
+	}
+}
+
+func TestMoneyPeriods(t *testing.T) {
+	monthly := Money{100, Monthly}
+	if monthly.Yearly() != 1200 || monthly.Monthly() != 100 {
+		t.Errorf("monthly %v: yearly %v, monthly %v", monthly, monthly.Yearly(), monthly.Monthly())
+	}
+	yearly := Money{1200, Yearly}
+	if yearly.Yearly() != 1200 || yearly.Monthly() != 100 {
+		t.Errorf("yearly %v: yearly %v, monthly %v", yearly, yearly.Yearly(), yearly.Monthly())
+	}
+	if (Money{10.5, Once}).Rounded() != 11 {
+		t.Errorf("10,5 € rounded = %v, want 11", (Money{10.5, Once}).Rounded())
+	}
+}
+
+func TestArea(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    float64
+		wantErr bool
+	}{
+		{"54,5 m²", 54.5, false},
+		{"54 m2", 54, false},
+		{"1 200 m²", 1200, false},
+		{"1 200 m², hallinta-osuus", 1200, false},
+		{"850 m² (oma tontti, harjakatto)", 850, false},
+		{"0,5 ha", 5000, false},
+		{"2 HA", 20000, false},
+		{"1,2ha", 12000, false},
+		{"1,5 hehtaaria", 15000, false},
+		{"3 hallia", 3, false},
+		{"", 0, true},
+		{"Ei tiedossa", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := Area(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Area(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Area(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestFloor(t *testing.T) {
+	tests := []struct {
+		value   string
+		floor   int
+		total   int
+		wantErr bool
+	}{
+		{"3", 3, 0, false},
+		{"3/5", 3, 5, false},
+		{"3 / 5", 3, 5, false},
+		{"-1/4", -1, 4, false},
+		{"1/2 krs", 1, 2, false},
+		{"Katutaso", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		floor, total, err := Floor(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Floor(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if floor != tt.floor || total != tt.total {
+			t.Errorf("Floor(%q) = %v, %v, want %v, %v", tt.value, floor, total, tt.floor, tt.total)
+		}
+	}
+}
+
+func TestYesNo(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    bool
+		wantErr bool
+	}{
+		{"Kyllä", true, false},
+		{"kyllä, 2 kpl", true, false},
+		{"On", true, false},
+		{"Ei", false, false},
+		{"EI", false, false},
+		{"Ei tiedossa", false, false},
+		{"", false, true},
+		{"Tarkista", false, true},
+	}
+
+	for _, tt := range tests {
+		got, err := YesNo(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("YesNo(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("YesNo(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestDate(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"1.2.2021", time.Date(2021, 2, 1, 0, 0, 0, 0, finland), false},
+		{"01.02.2021", time.Date(2021, 2, 1, 0, 0, 0, 0, finland), false},
+		{"1.2.2021.", time.Date(2021, 2, 1, 0, 0, 0, 0, finland), false},
+		{"1.2.21", time.Date(2021, 2, 1, 0, 0, 0, 0, finland), false},
+		{"2021-02-01", time.Date(2021, 2, 1, 0, 0, 0, 0, finland), false},
+		{"heti", time.Time{}, true},
+		{"31.2.2021", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := Date(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Date(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Date(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
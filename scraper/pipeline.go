@@ -0,0 +1,187 @@
+package scraper
+
+import (
+	"context"
+	"oikotie/database/models"
+	"sync"
+)
+
+// Concurrency is the number of workers for each stage of the scraping pipeline
+type Concurrency struct {
+	Cards int
+	// Fence checks the listings of geofenced areas against the geofences in
+	// the database, it's idle without geofences
+	Fence int
+	// Locate resolves new areas one at a time, more workers only look up
+	// imported boundaries in parallel
+	Locate  int
+	Details int
+	Derive  int
+	Persist int
+	Images  int
+}
+
+// listingJob is passed through the stages of the pipeline
+type listingJob struct {
+	// area is the area the listing is attributed to, searched the area whose
+	// search returned it. Both are the searched area until locate
+	area     *models.Area
+	searched *models.Area
+	listing  *models.Listing
+	// unchanged is set when the card matches the stored one and refetching the
+	// details and images can be skipped
+	unchanged bool
+}
+
+// pipeline stops all of its stages on the first error or when its parent
+// context is cancelled
+type pipeline struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	once   sync.Once
+	err    error
+}
+
+func newPipeline(ctx context.Context) *pipeline {
+	p := &pipeline{}
+	p.ctx, p.cancel = context.WithCancel(ctx)
+	return p
+}
+
+func (p *pipeline) fail(err error) {
+	p.once.Do(func() {
+		p.err = err
+		p.cancel()
+	})
+}
+
+func (p *pipeline) failed() bool {
+	return p.ctx.Err() != nil
+}
+
+// Err returns the first error of a stage, or the parent context's error if it
+// was cancelled. It must only be called once the last stage has been drained
+func (p *pipeline) Err() error {
+	if p.err != nil {
+		return p.err
+	}
+
+	return p.ctx.Err()
+}
+
+// send forwards v to out unless the pipeline has failed
+func (p *pipeline) send(out chan<- *listingJob, job *listingJob) bool {
+	select {
+	case out <- job:
+		return true
+	case <-p.ctx.Done():
+		return false
+	}
+}
+
+// stage runs f for every job from in using the given number of workers. Jobs
+// for which f returns true are forwarded to the returned channel, which is
+// closed once in has been drained
+func (p *pipeline) stage(workers int, in <-chan *listingJob, f func(context.Context, *listingJob) (bool, error)) <-chan *listingJob {
+	out := make(chan *listingJob)
+
+	var wg sync.WaitGroup
+	for i := 0; i < atLeastOne(workers); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range in {
+				if p.failed() {
+					continue
+				}
+
+				forward, err := f(p.ctx, job)
+				if err != nil {
+					p.fail(err)
+					continue
+				}
+
+				if forward {
+					p.send(out, job)
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// jobs emits the given jobs
+func (p *pipeline) jobs(jobs []*listingJob) <-chan *listingJob {
+	out := make(chan *listingJob)
+
+	go func() {
+		defer close(out)
+		for _, job := range jobs {
+			if !p.send(out, job) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// source runs f for every area using the given number of workers. f emits the
+// jobs it produces through the provided function
+func (p *pipeline) source(workers int, areas []*models.Area, f func(context.Context, *models.Area, func(*listingJob) bool) error) <-chan *listingJob {
+	in := make(chan *models.Area)
+	out := make(chan *listingJob)
+
+	go func() {
+		defer close(in)
+		for _, area := range areas {
+			select {
+			case in <- area:
+			case <-p.ctx.Done():
+				return
+			}
+		}
+	}()
+
+	emit := func(job *listingJob) bool {
+		return p.send(out, job)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < atLeastOne(workers); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for area := range in {
+				if p.failed() {
+					continue
+				}
+
+				err := f(p.ctx, area, emit)
+				if err != nil {
+					p.fail(err)
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+func atLeastOne(n int) int {
+	if n < 1 {
+		return 1
+	}
+	return n
+}
@@ -0,0 +1,197 @@
+package scraper
+
+import (
+	"context"
+	"errors"
+	"oikotie/database/models"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testAreas(n int) []*models.Area {
+	areas := make([]*models.Area, n)
+	for i := range areas {
+		areas[i] = &models.Area{ID: i + 1}
+	}
+	return areas
+}
+
+// emitListings returns a source emitting count listings for each area, or
+// listings until the pipeline stops when count is negative
+func emitListings(count int) func(context.Context, *models.Area, func(*listingJob) bool) error {
+	return func(ctx context.Context, area *models.Area, emit func(*listingJob) bool) error {
+		for i := 0; count < 0 || i < count; i++ {
+			job := &listingJob{area: area, listing: &models.Listing{ExternalID: area.ID*1000 + i}}
+			if !emit(job) {
+				return nil
+			}
+		}
+		return nil
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	const workers = 4
+
+	p := newPipeline(context.Background())
+	defer p.cancel()
+
+	jobs := p.source(2, testAreas(3), emitListings(10))
+	// Odd listings aren't forwarded
+	even := p.stage(1, jobs, func(_ context.Context, job *listingJob) (bool, error) {
+		return job.listing.ExternalID%2 == 0, nil
+	})
+
+	// The first jobs are held until every worker has one
+	var active, maxActive, n int32
+	started := make(chan struct{}, workers)
+	release := make(chan struct{})
+	out := p.stage(workers, even, func(_ context.Context, job *listingJob) (bool, error) {
+		a := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		for {
+			max := atomic.LoadInt32(&maxActive)
+			if a <= max || atomic.CompareAndSwapInt32(&maxActive, max, a) {
+				break
+			}
+		}
+
+		if atomic.AddInt32(&n, 1) <= workers {
+			started <- struct{}{}
+			<-release
+		}
+		return true, nil
+	})
+
+	for i := 0; i < workers; i++ {
+		select {
+		case <-started:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("only %d of %d workers ran at once", i, workers)
+		}
+	}
+	close(release)
+
+	seen := map[int]bool{}
+	for job := range out {
+		if job.listing.ExternalID%2 != 0 || seen[job.listing.ExternalID] {
+			t.Errorf("got listing %d", job.listing.ExternalID)
+		}
+		seen[job.listing.ExternalID] = true
+	}
+
+	if err := p.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(seen) != 15 {
+		t.Errorf("got %d listings, want 15", len(seen))
+	}
+	if maxActive > workers {
+		t.Errorf("%d workers ran at once, want at most %d", maxActive, workers)
+	}
+}
+
+func TestPipelineStageError(t *testing.T) {
+	failure := errors.New("failed")
+
+	p := newPipeline(context.Background())
+	defer p.cancel()
+
+	jobs := p.source(2, testAreas(2), emitListings(-1))
+	out := p.stage(2, jobs, func(_ context.Context, job *listingJob) (bool, error) {
+		if job.listing.ExternalID%1000 == 5 {
+			return false, failure
+		}
+		return true, nil
+	})
+
+	for range out {
+	}
+
+	if err := p.Err(); err != failure {
+		t.Errorf("Err() = %v, want %v", err, failure)
+	}
+}
+
+func TestPipelineSourceError(t *testing.T) {
+	failure := errors.New("failed")
+
+	p := newPipeline(context.Background())
+	defer p.cancel()
+
+	var areas int32
+	jobs := p.source(1, testAreas(5), func(ctx context.Context, area *models.Area, emit func(*listingJob) bool) error {
+		atomic.AddInt32(&areas, 1)
+		if area.ID == 2 {
+			return failure
+		}
+		return emitListings(3)(ctx, area, emit)
+	})
+	out := p.stage(1, jobs, func(context.Context, *listingJob) (bool, error) {
+		return true, nil
+	})
+
+	for range out {
+	}
+
+	if err := p.Err(); err != failure {
+		t.Errorf("Err() = %v, want %v", err, failure)
+	}
+	// Areas after the failed one are skipped
+	if n := atomic.LoadInt32(&areas); n != 2 {
+		t.Errorf("%d areas scraped, want 2", n)
+	}
+}
+
+func TestPipelineCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := newPipeline(ctx)
+	defer p.cancel()
+
+	jobs := p.source(2, testAreas(2), emitListings(-1))
+	var once sync.Once
+	out := p.stage(2, jobs, func(_ context.Context, job *listingJob) (bool, error) {
+		if job.listing.ExternalID%1000 == 3 {
+			once.Do(cancel)
+		}
+		return true, nil
+	})
+
+	for range out {
+	}
+
+	if err := p.Err(); err != context.Canceled {
+		t.Errorf("Err() = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestPipelineJobs(t *testing.T) {
+	p := newPipeline(context.Background())
+	defer p.cancel()
+
+	jobs := []*listingJob{}
+	for i := 0; i < 5; i++ {
+		jobs = append(jobs, &listingJob{listing: &models.Listing{ExternalID: i}})
+	}
+
+	var count int32
+	out := p.stage(3, p.jobs(jobs), func(context.Context, *listingJob) (bool, error) {
+		atomic.AddInt32(&count, 1)
+		return false, nil
+	})
+
+	for range out {
+		t.Error("got a job that wasn't forwarded")
+	}
+
+	if err := p.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if count != 5 {
+		t.Errorf("stage ran for %d jobs, want 5", count)
+	}
+}
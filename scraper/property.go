@@ -0,0 +1,144 @@
+package scraper
+
+import (
+	"oikotie/database/models"
+	"oikotie/filter"
+	"oikotie/scraper/parse"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+)
+
+// PropertyType is the type of property a listing is for
+type PropertyType string
+
+const (
+	Apartment         PropertyType = "apartment"
+	RowHouse          PropertyType = "row_house"
+	SemiDetachedHouse PropertyType = "semi_detached_house"
+	DetachedHouse     PropertyType = "detached_house"
+	Plot              PropertyType = "plot"
+)
+
+// buildingPropertyTypes maps the building type filters to the property types
+// of the listings they find
+var buildingPropertyTypes = map[filter.BuildingType]PropertyType{
+	filter.ApartmentBlock:    Apartment,
+	filter.RowHouse:          RowHouse,
+	filter.SemiDetachedHouse: SemiDetachedHouse,
+	filter.DetachedHouse:     DetachedHouse,
+}
+
+// searchedPropertyTypes are the property types the search of a kind finds
+func searchedPropertyTypes(kind filter.Kind, f filter.Filters) []string {
+	if !kind.HasBuildings() {
+		return []string{string(Plot)}
+	}
+
+	types := []string{}
+	for _, t := range f.Buildings() {
+		types = append(types, string(buildingPropertyTypes[t]))
+	}
+	return types
+}
+
+// propertyFields lists which of the type dependent fields apply to a property type
+type propertyFields struct {
+	// floor is required for apartments, for other types it's parsed if present
+	floor   bool
+	rooms   bool
+	lotSize bool
+}
+
+var propertyTypeFields = map[PropertyType]propertyFields{
+	Apartment:         {floor: true, rooms: true},
+	RowHouse:          {rooms: true},
+	SemiDetachedHouse: {rooms: true, lotSize: true},
+	DetachedHouse:     {rooms: true, lotSize: true},
+	Plot:              {lotSize: true},
+}
+
+// buildingTypeNames maps the building type shown on the detail page to a property type
+var buildingTypeNames = []struct {
+	name         string
+	propertyType PropertyType
+}{
+	{"rivitalo", RowHouse},
+	{"paritalo", SemiDetachedHouse},
+	{"omakotitalo", DetachedHouse},
+	{"erillistalo", DetachedHouse},
+	{"kerrostalo", Apartment},
+	{"luhtitalo", Apartment},
+}
+
+// cardSubTypes maps the cardSubType of a card, which uses the building type
+// codes of the search, to a property type
+var cardSubTypes = map[int]PropertyType{
+	1:   Apartment,
+	2:   RowHouse,
+	4:   DetachedHouse,
+	8:   SemiDetachedHouse,
+	256: Apartment,
+}
+
+var buildingTypeKeys = []string{"Rakennuksen tyyppi", "Talotyyppi"}
+var lotSizeKeys = []string{"Tontin pinta-ala", "Tontin koko"}
+
+// propertyTypeOf resolves the property type from the detail page's building
+// type, falling back to the card's sub type and finally to an apartment
+func propertyTypeOf(kind string, card Card, details listingDetails) PropertyType {
+	if kind == string(filter.Plot) {
+		return Plot
+	}
+
+	if value, ok := findDetail(details, buildingTypeKeys...); ok {
+		lower := strings.ToLower(value)
+		for _, b := range buildingTypeNames {
+			if strings.Contains(lower, b.name) {
+				return b.propertyType
+			}
+		}
+	}
+
+	if card.SubType.Valid {
+		if t, ok := cardSubTypes[card.SubType.Int]; ok {
+			return t
+		}
+	}
+
+	return Apartment
+}
+
+// setPropertyFields parses the fields that depend on the property type
+func setPropertyFields(listing *models.Listing, card Card, details listingDetails, errs ParseErrors) {
+	fields := propertyTypeFields[PropertyType(listing.PropertyType)]
+
+	if card.Rooms.Valid {
+		listing.Rooms = card.Rooms
+	} else if fields.rooms && !errs.has("card.rooms") {
+		errs.add("card.rooms", errMissing)
+	}
+
+	if value, ok := findDetail(details, "Kerros"); ok || fields.floor {
+		floor, _, err := parse.Floor(value)
+		if err == nil {
+			listing.Floor = null.IntFrom(floor)
+		} else if fields.floor {
+			errs.add("floor", err)
+		}
+	}
+
+	if fields.lotSize {
+		if value, ok := findDetail(details, lotSizeKeys...); ok {
+			size, err := parse.Area(value)
+			if err != nil {
+				errs.add("lot_size", err)
+			} else {
+				listing.LotSize = null.Float64From(size)
+			}
+		} else if PropertyType(listing.PropertyType) == Plot && listing.Size > 0 {
+			// The size of a plot card is the size of the plot
+			listing.LotSize = null.Float64From(listing.Size)
+		}
+	}
+}
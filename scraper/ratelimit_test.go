@@ -0,0 +1,76 @@
+package scraper
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestHostLimiter(t *testing.T) {
+	// A request every 100ms per host
+	l := newHostLimiter(10)
+	ctx := context.Background()
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := l.Wait(ctx, "a.example"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The first request starts right away, the workers share the limit
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("3 requests started in %v, want at least 200ms", elapsed)
+	}
+
+	// Another host isn't held back
+	start = time.Now()
+	if err := l.Wait(ctx, "b.example"); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed >= 100*time.Millisecond {
+		t.Errorf("request to another host waited %v", elapsed)
+	}
+}
+
+func TestHostLimiterCancel(t *testing.T) {
+	l := newHostLimiter(0.1)
+
+	err := l.Wait(context.Background(), "a.example")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = l.Wait(ctx, "a.example")
+	if err != context.DeadlineExceeded {
+		t.Errorf("Wait() = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("cancelled wait took %v", elapsed)
+	}
+}
+
+func TestHostLimiterUnlimited(t *testing.T) {
+	l := newHostLimiter(0)
+
+	start := time.Now()
+	for i := 0; i < 100; i++ {
+		if err := l.Wait(context.Background(), "a.example"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("100 unlimited requests took %v", elapsed)
+	}
+}
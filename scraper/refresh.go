@@ -0,0 +1,128 @@
+package scraper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"oikotie/database/models"
+	"sync"
+	"time"
+)
+
+// RefreshResult is the outcome of a refresh
+type RefreshResult struct {
+	// Refreshed are the listings saved with their new details
+	Refreshed []*models.Listing
+	// Removed are the listings whose detail page no longer exists, they are
+	// marked removed
+	Removed []*models.Listing
+	// Failed counts the listings whose details or images failed to fetch,
+	// a listing whose details failed isn't saved
+	Failed int
+}
+
+// Refresh fetches the detail pages and images of stored listings again and
+// saves them like a run does, recording a snapshot of details that changed.
+// A listing that fails to fetch is logged and skipped, one whose detail page
+// is gone is marked removed. The result is partial on error
+func (s *Scraper) Refresh(ctx context.Context, listings []*models.Listing) (RefreshResult, error) {
+	var result RefreshResult
+	if len(listings) == 0 {
+		return result, nil
+	}
+
+	s.fetcher = newFetcher(s.fetchOptions)
+
+	areaIDs := []int{}
+	for _, listing := range listings {
+		areaIDs = append(areaIDs, listing.AreaID)
+	}
+	areas, err := models.Areas(models.AreaWhere.ID.IN(areaIDs)).All(ctx, s.db)
+	if err != nil {
+		return result, err
+	}
+	areasByID := map[int]*models.Area{}
+	for _, area := range areas {
+		areasByID[area.ID] = area
+	}
+
+	jobs := make([]*listingJob, len(listings))
+	for i, listing := range listings {
+		area, ok := areasByID[listing.AreaID]
+		if !ok {
+			return result, fmt.Errorf("area %d of listing %d not found", listing.AreaID, listing.ExternalID)
+		}
+
+		listing.DateAccessed = time.Now()
+		jobs[i] = &listingJob{area: area, listing: listing}
+	}
+
+	var mu sync.Mutex
+
+	// skip logs and counts a failed listing, unless the refresh was cancelled
+	skip := func(ctx context.Context, job *listingJob, err error) (bool, error) {
+		if ctx.Err() != nil {
+			return false, err
+		}
+		log.Printf("Skipping listing %d, %v", job.listing.ExternalID, err)
+
+		mu.Lock()
+		result.Failed++
+		mu.Unlock()
+
+		return false, nil
+	}
+
+	c := s.options.Concurrency
+	p := newPipeline(ctx)
+	defer p.cancel()
+
+	detailed := p.stage(c.Details, p.jobs(jobs), func(ctx context.Context, job *listingJob) (bool, error) {
+		_, err := s.fetchDetails(ctx, job)
+		if errors.Is(err, errListingNotFound) {
+			if !job.listing.RemovedAt.Valid {
+				err = s.setRemoved(ctx, job.listing)
+				if err != nil {
+					return false, err
+				}
+			}
+
+			mu.Lock()
+			result.Removed = append(result.Removed, job.listing)
+			mu.Unlock()
+
+			return false, nil
+		}
+		if err != nil {
+			return skip(ctx, job, err)
+		}
+
+		return true, nil
+	})
+	derived := p.stage(c.Derive, detailed, deriveFields)
+	persisted := p.stage(c.Persist, derived, func(ctx context.Context, job *listingJob) (bool, error) {
+		err := s.persist(ctx, job)
+		if err != nil {
+			return false, err
+		}
+
+		mu.Lock()
+		result.Refreshed = append(result.Refreshed, job.listing)
+		mu.Unlock()
+
+		return true, nil
+	})
+	downloaded := p.stage(c.Images, persisted, func(ctx context.Context, job *listingJob) (bool, error) {
+		err := s.downloadImages(ctx, job.area, job.listing)
+		if err != nil {
+			return skip(ctx, job, fmt.Errorf("images: %w", err))
+		}
+		return false, nil
+	})
+
+	for range downloaded {
+	}
+
+	return result, p.Err()
+}
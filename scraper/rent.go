@@ -0,0 +1,101 @@
+package scraper
+
+import (
+	"math"
+	"oikotie/database/models"
+	"oikotie/scraper/parse"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+)
+
+var rentKeys = []string{"Vuokra", "Vuokra/kk"}
+var depositKeys = []string{"Vakuus", "Vuokravakuus"}
+var minLeaseKeys = []string{"Vähimmäisvuokra-aika", "Vuokrasopimuksen kesto", "Vuokra-aika"}
+
+var monthsReg = regexp.MustCompile(`([0-9]+)\s*(kk|kuukau)`)
+var yearsReg = regexp.MustCompile(`([0-9]+)\s*(v\b|vuo)`)
+
+// setRentFields parses the fields specific to rental listings. The card price
+// of a rental listing is its monthly rent, used unless the details have one
+func setRentFields(listing *models.Listing, details listingDetails, errs ParseErrors) {
+	listing.MonthlyRent = null.IntFrom(listing.Price)
+	if value, ok := findDetail(details, rentKeys...); ok {
+		rent, err := parse.Euros(value)
+		if err != nil {
+			errs.add("monthly_rent", err)
+		} else {
+			listing.MonthlyRent = null.IntFrom(int(math.Round(rent.Monthly())))
+		}
+	}
+
+	if value, ok := findDetail(details, depositKeys...); ok {
+		deposit, err := parseDeposit(value, listing.MonthlyRent.Int)
+		if err != nil {
+			errs.add("deposit", err)
+		} else {
+			listing.Deposit = deposit
+		}
+	}
+
+	if value, ok := findDetail(details, minLeaseKeys...); ok {
+		listing.MinLeaseMonths = parseLeaseMonths(value)
+	}
+}
+
+// findDetail returns the first of keys found in any section of the details
+func findDetail(details listingDetails, keys ...string) (string, bool) {
+	for _, key := range keys {
+		for _, section := range details {
+			if value, ok := section[key]; ok {
+				return value, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// parseDeposit parses deposits given either in euros, e.g. "2 100 €", or in
+// months of rent, e.g. "2 kk vuokra"
+func parseDeposit(value string, rent int) (null.Int, error) {
+	lower := strings.ToLower(value)
+	if strings.HasPrefix(lower, "ei") {
+		return null.IntFrom(0), nil
+	}
+
+	if m := monthsReg.FindStringSubmatch(lower); m != nil && !strings.Contains(lower, "€") {
+		months, err := strconv.Atoi(m[1])
+		if err != nil {
+			return null.Int{}, err
+		}
+		return null.IntFrom(months * rent), nil
+	}
+
+	deposit, err := parse.Euros(value)
+	if err != nil {
+		return null.Int{}, err
+	}
+
+	return null.IntFrom(deposit.Rounded()), nil
+}
+
+// parseLeaseMonths parses a minimum lease such as "12 kk" or "1 vuosi", leases
+// without a fixed minimum, e.g. "Toistaiseksi voimassa oleva", give null
+func parseLeaseMonths(value string) null.Int {
+	lower := strings.ToLower(value)
+
+	if m := monthsReg.FindStringSubmatch(lower); m != nil {
+		months, _ := strconv.Atoi(m[1])
+		return null.IntFrom(months)
+	}
+
+	if m := yearsReg.FindStringSubmatch(lower); m != nil {
+		years, _ := strconv.Atoi(m[1])
+		return null.IntFrom(years * 12)
+	}
+
+	return null.Int{}
+}
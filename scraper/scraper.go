@@ -1,33 +1,36 @@
 package scraper
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"oikotie/database"
 	"oikotie/database/models"
+	"oikotie/filter"
+	"oikotie/geo"
 	"os"
 	"path/filepath"
-	"regexp"
+	"reflect"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/friendsofgo/errors"
-	"github.com/hashicorp/go-retryablehttp"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
-	"github.com/volatiletech/sqlboiler/v4/types/pgeo"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
-const cardsURL = "https://asunnot.oikotie.fi/api/cards"
-
-var acceptedCardIDs = map[int]interface{}{
-	4: struct{}{}, // kaupunginosa
-	5: struct{}{}, // postinumeroalue
-}
+// DefaultBaseURL is the Oikotie site scraped unless configured otherwise
+const DefaultBaseURL = "https://asunnot.oikotie.fi"
 
 type requestParams struct {
 	token   string
@@ -36,57 +39,124 @@ type requestParams struct {
 	cookies []*http.Cookie
 }
 
-type apiArea struct {
-	Card struct {
-		Name     string `json:"name"`
-		CardID   int    `json:"cardId"`
-		CardType int    `json:"cardType"`
-	} `json:"card"`
-	Parent struct {
-		Name string `json:"name"`
-	} `json:"parent"`
+type scraperOptions struct {
+	MaxPrice int
+	MinPrice int
+	MaxSize  int
+	MinSize  int
+	Areas    []AreaQuery
+	// TrackedAreas scrapes the areas tracked in the DB instead of Areas
+	TrackedAreas bool
+	// Geofences are searched through the postcode areas covering them, see SetGeofences
+	Geofences []geo.Geofence
+	// MaxListingsPerArea caps the number of listings fetched per area, 0 means no limit
+	MaxListingsPerArea int
+	// Full disables incremental mode, refetching details and images of every listing
+	Full bool
+	// FullScanEvery is how often an incremental run scans an area fully to
+	// detect removed listings, 0 means never
+	FullScanEvery time.Duration
+	Concurrency   Concurrency
+	// BaseURL is the Oikotie site all requests are made against, without a trailing slash
+	BaseURL string
+	Kind    filter.Kind
+	Filters filter.Filters
 }
 
-type scraperOptions struct {
-	MaxPrice  int
-	MinPrice  int
-	MaxSize   int
-	MinSize   int
-	AreaCodes []string
+// PriceChange is emitted when a known listing is seen with a different asking price
+type PriceChange struct {
+	Listing  *models.Listing
+	OldPrice int
+	NewPrice int
+}
+
+// Percentage returns the relative change from the old price, negative for price drops
+func (c PriceChange) Percentage() float64 {
+	if c.OldPrice == 0 {
+		return 0
+	}
+	return float64(c.NewPrice-c.OldPrice) / float64(c.OldPrice) * 100
 }
 
 type Scraper struct {
 	options       scraperOptions
 	db            *sql.DB
-	requestParams *requestParams
-	client        *http.Client
+	session       session
+	sessionMu     sync.RWMutex
+	refreshMu     sync.Mutex
+	fetchOptions  FetcherOptions
+	fetcher       *fetcher
+	onPriceChange func(PriceChange)
+	onRemoved     func(*models.Listing)
+	// hookMu serializes hook calls made from the pipeline workers
+	hookMu sync.Mutex
+	// areaCache holds the areas resolved by locate during a run
+	areaCache   map[string]*models.Area
+	areaCacheMu sync.Mutex
+	// geofenceIDs are the stored ids of the configured geofences
+	geofenceIDs []int
+	// fencedAreas are the areas only searched for a geofence by id
+	fencedAreas map[int]bool
 }
 
 // Create Initialize with default values
 func Create(db *sql.DB) *Scraper {
 	search := &Scraper{
 		options: scraperOptions{
-			MaxPrice:  800000,
-			MinPrice:  1,
-			MaxSize:   100,
-			MinSize:   1,
-			AreaCodes: []string{"00200"},
+			MaxPrice: 800000,
+			MinPrice: 1,
+			MaxSize:  100,
+			MinSize:  1,
+			Areas:    []AreaQuery{{Query: "00200"}},
+			BaseURL:  DefaultBaseURL,
+			// A week of incremental runs only misses removals, which are
+			// reported late rather than wrong
+			FullScanEvery: 7 * 24 * time.Hour,
+			Kind:          filter.Sale,
+			Concurrency: Concurrency{
+				Cards:   1,
+				Fence:   2,
+				Locate:  2,
+				Details: 4,
+				Derive:  1,
+				Persist: 2,
+				Images:  4,
+			},
 		},
-		db: db,
+		db:           db,
+		fetchOptions: DefaultFetcherOptions(),
 	}
 
-	retryClient := retryablehttp.NewClient()
-	retryClient.RetryWaitMin = time.Minute * 20
-	retryClient.RetryWaitMax = time.Hour
-	retryClient.RetryMax = 5
-
-	search.client = retryClient.StandardClient()
-
 	return search
 }
 
+// SetAreaCodes sets the areas to scrape by query, e.g. a postcode or "Otaniemi, Espoo"
 func (s *Scraper) SetAreaCodes(areaCodes []string) *Scraper {
-	s.options.AreaCodes = areaCodes
+	s.options.Areas = make([]AreaQuery, len(areaCodes))
+	for i, code := range areaCodes {
+		s.options.Areas[i] = AreaQuery{Query: code}
+	}
+	return s
+}
+
+// SetTrackedAreas makes the scraper scrape the areas tracked in the DB, see
+// AddArea, instead of the ones set with SetAreas
+func (s *Scraper) SetTrackedAreas(tracked bool) *Scraper {
+	s.options.TrackedAreas = tracked
+	return s
+}
+
+// SetGeofences sets polygons to scrape. The postcode areas overlapping them are
+// searched, keeping the listings inside a polygon. Listings record the first
+// geofence they're in
+func (s *Scraper) SetGeofences(fences []geo.Geofence) *Scraper {
+	s.options.Geofences = fences
+	return s
+}
+
+// SetAreas sets the areas to scrape, queries can be pinned to a card
+func (s *Scraper) SetAreas(areas []AreaQuery) *Scraper {
+	s.options.Areas = areas
 	return s
 }
 
@@ -102,148 +172,398 @@ func (s *Scraper) SetSize(min int, max int) *Scraper {
 	return s
 }
 
-func (s *Scraper) Run() ([]*models.Listing, error) {
-	params, err := getRequestParams()
+func (s *Scraper) SetMaxListingsPerArea(max int) *Scraper {
+	s.options.MaxListingsPerArea = max
+	return s
+}
+
+func (s *Scraper) SetFull(full bool) *Scraper {
+	s.options.Full = full
+	return s
+}
+
+// SetFullScanEvery sets how often an area is scanned fully in incremental
+// mode, which is what detects removed listings. 0 never scans fully
+func (s *Scraper) SetFullScanEvery(every time.Duration) *Scraper {
+	s.options.FullScanEvery = every
+	return s
+}
+
+// SetBaseURL points the scraper at another Oikotie compatible site, e.g. a fake server
+func (s *Scraper) SetBaseURL(baseURL string) *Scraper {
+	s.options.BaseURL = strings.TrimSuffix(baseURL, "/")
+	return s
+}
+
+// SetKind sets whether listings for sale or for rent are scraped
+func (s *Scraper) SetKind(kind filter.Kind) *Scraper {
+	s.options.Kind = kind.OrDefault()
+	return s
+}
+
+func (s *Scraper) SetFilters(f filter.Filters) *Scraper {
+	s.options.Filters = f
+	return s
+}
+
+// SetConcurrency sets the number of workers for each stage, stages with 0 workers get one
+func (s *Scraper) SetConcurrency(c Concurrency) *Scraper {
+	s.options.Concurrency = c
+	return s
+}
+
+// SetFetcherOptions configures retries, timeouts, rate limiting and the
+// transport of all requests made by the scraper
+func (s *Scraper) SetFetcherOptions(o FetcherOptions) *Scraper {
+	s.fetchOptions = o
+	return s
+}
+
+// OnPriceChange registers a hook called for every listing whose price has changed
+// since it was last seen
+func (s *Scraper) OnPriceChange(f func(PriceChange)) *Scraper {
+	s.onPriceChange = f
+	return s
+}
+
+// OnRemoved registers a hook called for every listing that has disappeared from
+// its area's results since the previous run
+func (s *Scraper) OnRemoved(f func(*models.Listing)) *Scraper {
+	s.onRemoved = f
+	return s
+}
+
+// Run scrapes all configured areas. When ctx is cancelled, or a stage fails, the
+// listings saved so far are returned along with the error, and no listings are
+// marked removed
+func (s *Scraper) Run(ctx context.Context) ([]*models.Listing, error) {
+	s.fetcher = newFetcher(s.fetchOptions)
+
+	err := s.startSession(ctx)
 	if err != nil {
 		return nil, err
 	}
-	s.requestParams = &params
 
-	areas, err := s.getAreas(s.options.AreaCodes)
+	var areas []*models.Area
+	if s.options.TrackedAreas {
+		areas, err = s.getTrackedAreas(ctx)
+	} else {
+		areas, err = s.getAreas(ctx, s.options.Areas)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	areas, err = s.addGeofenceAreas(ctx, areas)
+	if err != nil {
+		return nil, err
+	}
+
+	// Areas resolved since the boundaries were imported are linked to theirs
+	_, err = geo.LinkAreas(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	if unscoped := s.options.Filters.Unscoped(); len(unscoped) > 0 {
+		log.Printf("Not detecting removed listings, stored listings can't be filtered by %s", strings.Join(unscoped, ", "))
+	}
+
+	var mu sync.Mutex
 	l := []*models.Listing{}
-	for _, area := range areas {
-		nl, err := s.getListings(area)
-		if err != nil {
-			return nil, err
+	scans := []*fullScan{}
+
+	c := s.options.Concurrency
+	p := newPipeline(ctx)
+	defer p.cancel()
+
+	jobs := p.source(c.Cards, areas, func(ctx context.Context, area *models.Area, emit func(*listingJob) bool) error {
+		scan, err := s.getListings(ctx, area, emit)
+		if err != nil || scan == nil {
+			return err
 		}
-		for _, listing := range nl {
-			err := downloadImages(area, listing)
-			if err != nil {
-				return nil, err
-			}
+
+		mu.Lock()
+		scans = append(scans, scan)
+		mu.Unlock()
+
+		return nil
+	})
+	fenced := p.stage(c.Fence, jobs, s.fence)
+	located := p.stage(c.Locate, fenced, s.locate)
+	detailed := p.stage(c.Details, located, s.fetchDetails)
+	derived := p.stage(c.Derive, detailed, deriveFields)
+	persisted := p.stage(c.Persist, derived, func(ctx context.Context, job *listingJob) (bool, error) {
+		err := s.persist(ctx, job)
+		if err != nil {
+			return false, err
 		}
 
-		l = append(l, nl...)
+		mu.Lock()
+		l = append(l, job.listing)
+		mu.Unlock()
+
+		return !job.unchanged, nil
+	})
+	downloaded := p.stage(c.Images, persisted, func(ctx context.Context, job *listingJob) (bool, error) {
+		return false, s.downloadImages(ctx, job.area, job.listing)
+	})
+
+	for range downloaded {
+	}
+
+	err = p.Err()
+	if err != nil {
+		return l, err
+	}
+
+	// Removals are only marked once every job has been persisted, a listing
+	// missing from one area's results may be saved through another area's
+	for _, scan := range scans {
+		err = s.finishFullScan(ctx, scan)
+		if err != nil {
+			return l, err
+		}
 	}
 
 	return l, nil
 }
 
-func (s *Scraper) getAreas(areaCodes []string) ([]*models.Area, error) {
-	areasInDB, err := models.Areas(models.AreaWhere.Name.IN(areaCodes)).All(s.db)
-	if err != nil {
-		return nil, err
-	}
+func (s *Scraper) apiCall(ctx context.Context, endpoint string) *http.Request {
+	url := s.options.BaseURL + "/api/3.0/" + endpoint
+	req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+	return req
+}
+
+// cardsPageSize is the number of cards requested per page from the cards API
+const cardsPageSize = 24
+
+type cardsResponse struct {
+	Found int               `json:"found"`
+	Cards []json.RawMessage `json:"cards"`
+}
+
+// incrementalStopAfter is the number of consecutive known, unchanged listings
+// after which an incremental run stops paging through an area
+const incrementalStopAfter = 10
 
-	for _, areaCode := range areaCodes {
-		inDB := false
-		for _, area := range areasInDB {
-			if area.Name == areaCode {
-				inDB = true
+// fullScan is an area whose whole result set was paged through, with the
+// external ids of the listings seen
+type fullScan struct {
+	area *models.Area
+	seen []int
+}
+
+// getListings pages through the cards of an area and emits a job for each of
+// them. The full scan is returned if the whole result set was paged through,
+// i.e. neither the per-area cap nor the incremental stop was hit, for
+// finishFullScan to mark the listings that weren't seen removed. An
+// incremental run scans the area fully when its last full scan is older than
+// FullScanEvery, still skipping the details of unchanged listings
+func (s *Scraper) getListings(ctx context.Context, area *models.Area, emit func(*listingJob) bool) (*fullScan, error) {
+	seen := []int{}
+	knownInARow := 0
+	complete := false
+	incremental := s.incremental() && !s.fullScanDue(area)
+
+pages:
+	for offset := 0; ; offset += cardsPageSize {
+		limit := cardsPageSize
+		if max := s.options.MaxListingsPerArea; max > 0 {
+			if offset >= max {
 				break
 			}
+			if max-offset < limit {
+				limit = max - offset
+			}
 		}
 
-		if !inDB {
-			area, err := s.getArea(areaCode)
-			if err != nil {
-				return nil, err
-			}
+		page, err := s.getCardsPage(ctx, area, offset, limit)
+		if err != nil {
+			return nil, err
+		}
 
-			exists, err := models.Areas(models.AreaWhere.ExternalID.EQ(area.Card.CardID)).Exists(s.db)
+		for _, card := range page.Cards {
+			job, err := s.newListingJob(ctx, area, card)
 			if err != nil {
 				return nil, err
 			}
 
-			if exists {
-				continue
+			seen = append(seen, job.listing.ExternalID)
+			if !emit(job) {
+				return nil, nil
 			}
 
-			dbArea := models.Area{
-				ExternalID: area.Card.CardID,
-				Name:       area.Card.Name,
-				CardType:   area.Card.CardType,
-				City:       area.Parent.Name,
+			if job.unchanged {
+				knownInARow++
+			} else {
+				knownInARow = 0
 			}
-
-			err = dbArea.Insert(s.db, boil.Infer())
-			if err != nil {
-				return nil, err
+			if incremental && knownInARow >= incrementalStopAfter {
+				break pages
 			}
 		}
+
+		if len(page.Cards) == 0 || offset+len(page.Cards) >= page.Found {
+			complete = true
+			break
+		}
+	}
+
+	area.LastScrapedAt = null.TimeFrom(time.Now())
+	_, err := area.Update(ctx, s.db, boil.Whitelist(models.AreaColumns.LastScrapedAt))
+	if err != nil || !complete {
+		return nil, err
 	}
 
-	return models.Areas(models.AreaWhere.Name.IN(areaCodes)).All(s.db)
+	return &fullScan{area: area, seen: seen}, nil
 }
 
-func (s *Scraper) apiCall(endpoint string) *http.Request {
-	url := "https://asunnot.oikotie.fi/api/3.0/" + endpoint
-	req, _ := http.NewRequest("GET", url, nil)
-	req.Header.Set("ota-token", s.requestParams.token)
-	req.Header.Set("ota-cuid", s.requestParams.cuid)
-	req.Header.Set("ota-loaded", s.requestParams.loaded)
-	for _, cookie := range s.requestParams.cookies {
-		req.AddCookie(cookie)
+// finishFullScan marks the listings the scan didn't see removed and records
+// when the area was last scanned fully. It must only be called once the jobs
+// of every area have been persisted
+func (s *Scraper) finishFullScan(ctx context.Context, scan *fullScan) error {
+	err := s.markRemoved(ctx, scan.area, scan.seen)
+	if err != nil {
+		return err
 	}
 
-	return req
+	scan.area.LastFullScanAt = null.TimeFrom(time.Now())
+	_, err = scan.area.Update(ctx, s.db, boil.Whitelist(models.AreaColumns.LastFullScanAt))
+	return err
 }
 
-func (s *Scraper) getArea(areaCode string) (apiArea, error) {
-	req := s.apiCall("location")
+// fullScanDue reports whether the area's last full scan is older than FullScanEvery
+func (s *Scraper) fullScanDue(area *models.Area) bool {
+	every := s.options.FullScanEvery
+	if every <= 0 {
+		return false
+	}
+	return !area.LastFullScanAt.Valid || time.Since(area.LastFullScanAt.Time) >= every
+}
 
-	q := req.URL.Query()
-	q.Add("query", areaCode)
+// incremental reports whether paging can stop at known listings, which is only
+// the case when cards come newest first
+func (s *Scraper) incremental() bool {
+	return !s.options.Full && s.options.Filters.Sort() == filter.PublishedDesc
+}
 
-	req.URL.RawQuery = q.Encode()
+// markRemoved forgets the searches of the area that returned listings not in
+// its full result set. Listings no search returns anymore are marked removed,
+// keeping the last known price. A listing's own area doesn't matter, it may
+// be attributed to an area that isn't searched.
+//
+// Only listings the search could have returned are considered: those of the
+// kind, price, size, building types, conditions, lot ownership and
+// construction years searched. Nothing is marked removed when a filter the
+// stored listings can't be matched against is set, see filter.Filters.Unscoped
+func (s *Scraper) markRemoved(ctx context.Context, area *models.Area, seenIDs []int) error {
+	o := s.options
+	if len(o.Filters.Unscoped()) > 0 {
+		return nil
+	}
+
+	query := `
+		DELETE FROM listing_searches s
+		USING listings l
+		WHERE s.listing_id = l.id AND s.area_id = $1 AND NOT (l.external_id = ANY($2))
+			AND l.kind = $3 AND l.property_type = ANY($4)
+			AND l.price BETWEEN $5 AND $6 AND l.size BETWEEN $7 AND $8`
+	args := []interface{}{
+		area.ID, pq.Array(intsToInt64s(seenIDs)),
+		string(o.Kind), pq.Array(searchedPropertyTypes(o.Kind, o.Filters)),
+		o.MinPrice, o.MaxPrice, o.MinSize, o.MaxSize,
+	}
+	where := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		query += fmt.Sprintf(" AND "+condition, len(args))
+	}
+
+	// Listings without the detail stored are left alone
+	if f := o.Filters; len(f.Conditions) > 0 {
+		where("l.condition = ANY($%d)", pq.Array(f.Conditions))
+	}
+	if f := o.Filters; len(f.LotOwnership) > 0 {
+		where("l.lot_ownership = ANY($%d)", pq.Array(f.LotOwnership))
+	}
+	if y := o.Filters.ConstructionYear; y != nil {
+		if y.Min != 0 {
+			where("l.construction_year >= $%d", y.Min)
+		}
+		if y.Max != 0 {
+			where("l.construction_year <= $%d", y.Max)
+		}
+	}
 
-	resp, err := s.client.Do(req)
+	var gone []struct {
+		ListingID int `boil:"listing_id"`
+	}
+	err := queries.Raw(query+" RETURNING s.listing_id", args...).Bind(ctx, s.db, &gone)
 	if err != nil {
-		return apiArea{}, err
+		return err
+	}
+	if len(gone) == 0 {
+		return nil
+	}
+
+	ids := make([]int, len(gone))
+	for i, g := range gone {
+		ids[i] = g.ListingID
 	}
 
-	var allMatching []apiArea
-	err = json.NewDecoder(resp.Body).Decode(&allMatching)
+	removed, err := models.Listings(
+		models.ListingWhere.ID.IN(ids),
+		models.ListingWhere.RemovedAt.IsNull(),
+		qm.Where("NOT EXISTS (SELECT 1 FROM listing_searches s WHERE s.listing_id = listings.id)"),
+	).All(ctx, s.db)
 	if err != nil {
-		return apiArea{}, err
+		return err
 	}
 
-	cityAreas := make([]apiArea, 0)
-	for _, area := range allMatching {
-		if _, ok := acceptedCardIDs[area.Card.CardType]; ok {
-			cityAreas = append(cityAreas, area)
+	for _, listing := range removed {
+		err = s.setRemoved(ctx, listing)
+		if err != nil {
+			return err
 		}
 	}
 
-	if len(cityAreas) != 1 {
-		return apiArea{}, fmt.Errorf("Expected 1 card for code '%s', got %d", areaCode, len(cityAreas))
+	return nil
+}
+
+// setRemoved marks the listing removed at its last known price
+func (s *Scraper) setRemoved(ctx context.Context, listing *models.Listing) error {
+	listing.RemovedAt = null.TimeFrom(time.Now())
+	listing.RemovedPrice = null.IntFrom(listing.Price)
+
+	_, err := listing.Update(ctx, s.db, boil.Whitelist(models.ListingColumns.RemovedAt, models.ListingColumns.RemovedPrice))
+	if err != nil {
+		return err
 	}
 
-	return allMatching[0], nil
+	if s.onRemoved != nil {
+		s.hookMu.Lock()
+		s.onRemoved(listing)
+		s.hookMu.Unlock()
+	}
+
+	return nil
 }
 
-func (s *Scraper) getListings(area *models.Area) ([]*models.Listing, error) {
-	req, _ := http.NewRequest("GET", cardsURL, nil)
-	req.Header.Set("ota-token", s.requestParams.token)
-	req.Header.Set("ota-cuid", s.requestParams.cuid)
-	req.Header.Set("ota-loaded", s.requestParams.loaded)
-	for _, cookie := range s.requestParams.cookies {
-		req.AddCookie(cookie)
+func intsToInt64s(ints []int) []int64 {
+	int64s := make([]int64, len(ints))
+	for i, n := range ints {
+		int64s[i] = int64(n)
 	}
+	return int64s
+}
+
+func (s *Scraper) getCardsPage(ctx context.Context, area *models.Area, offset int, limit int) (cardsResponse, error) {
+	var page cardsResponse
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", s.options.BaseURL+"/api/cards", nil)
 
 	q := req.URL.Query()
-	q.Add("buildingType[]", "1") // Kerrostalo
-	q.Add("buildingType[]", "256")
-	q.Add("cardType", "100") // Not sure
-	// q.Add("conditionType[]", "1") // Erinomainen, Hyvä, Tyydyttävä, Välttävä, Huono
-	// q.Add("conditionType[]", "2")
-	// q.Add("conditionType[]", "4")
-	// q.Add("conditionType[]", "8")
-	// q.Add("conditionType[]", "16")
+	q.Add("cardType", strconv.Itoa(s.options.Kind.CardType()))
 	// areaStrings := make([]string, len(areas))
 	// for i, a := range areas {
 	// 	areaStrings[i] = fmt.Sprintf("[%d,%d,\"%s, Helsinki\"]", a.AreaID, a.CardType, a.Name)
@@ -251,75 +571,249 @@ func (s *Scraper) getListings(area *models.Area) ([]*models.Listing, error) {
 	// q.Add("locations", fmt.Sprintf("[%s]", strings.Join(areaStrings, ",")))
 	q.Add("locations", fmt.Sprintf("[[%d, %d,\"%s, %s\"]]", area.ExternalID, area.CardType, area.Name, area.City))
 
-	// q.Add("lotOwnershipType[]", "1") // Oma, Vuokralla, Valinnainen vuokratontti
-	// q.Add("lotOwnershipType[]", "2")
-	// q.Add("lotOwnershipType[]", "3")
 	q.Add("price[max]", strconv.Itoa(s.options.MaxPrice))
 	q.Add("price[min]", strconv.Itoa(s.options.MinPrice))
 	q.Add("size[max]", strconv.Itoa(s.options.MaxSize))
 	q.Add("size[min]", strconv.Itoa(s.options.MinSize))
-	q.Add("sortBy", "published_sort_desc")
-	// q.Add("limit", "1")
+	s.options.Filters.Apply(q, s.options.Kind)
+	q.Add("limit", strconv.Itoa(limit))
+	q.Add("offset", strconv.Itoa(offset))
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := s.client.Do(req)
+	resp, err := s.doAPI(req)
 	if err != nil {
-		return nil, err
+		return page, err
 	}
 
-	listings := []*models.Listing{}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return page, fmt.Errorf("Request failed %d %s, offset: %d", resp.StatusCode, resp.Status, offset)
+	}
 
-	var listingsResponse struct {
-		Cards []map[string]interface{} `json:"cards"`
+	err = json.NewDecoder(resp.Body).Decode(&page)
+	if err != nil {
+		return page, err
 	}
-	err = json.NewDecoder(resp.Body).Decode(&listingsResponse)
+
+	return page, nil
+}
+
+// newListingJob creates the listing for a card. In incremental mode a card
+// that matches the stored one is marked unchanged and reuses the stored details
+func (s *Scraper) newListingJob(ctx context.Context, area *models.Area, card json.RawMessage) (*listingJob, error) {
+	externalID, err := cardID(card)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, apiListing := range listingsResponse.Cards {
-		listing := &models.Listing{}
+	job := &listingJob{
+		area:     area,
+		searched: area,
+		listing: &models.Listing{
+			ExternalID:   externalID,
+			AreaID:       area.ID,
+			DateAccessed: time.Now(),
+			Kind:         string(s.options.Kind),
+		},
+	}
+
+	job.listing.ListingData = null.JSONFrom(card)
+
+	if s.options.Full {
+		return job, nil
+	}
+
+	known, err := models.Listings(models.ListingWhere.ExternalID.EQ(job.listing.ExternalID)).One(ctx, s.db)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	if known != nil && known.ListingDetails.Valid && cardsEqual(known.ListingData, job.listing.ListingData) {
+		job.unchanged = true
+		job.listing.ListingDetails = known.ListingDetails
+	}
 
-		err = listing.ListingData.Marshal(apiListing)
+	return job, nil
+}
+
+func (s *Scraper) fetchDetails(ctx context.Context, job *listingJob) (bool, error) {
+	if job.unchanged {
+		return true, nil
+	}
+
+	listingDetails, err := s.getListingDetails(ctx, job.listing, job.area)
+	if err != nil {
+		return false, err
+	}
+
+	err = job.listing.ListingDetails.Marshal(listingDetails)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func deriveFields(_ context.Context, job *listingJob) (bool, error) {
+	err := SetDerivedFields(job.listing)
+	if err != nil {
+		log.Printf("Failed to set derived fields, err: [%s], listing id: %d", err.Error(), job.listing.ExternalID)
+	}
+
+	return true, nil
+}
+
+func (s *Scraper) persist(ctx context.Context, job *listingJob) error {
+	listing := job.listing
+
+	previous, err := saveListing(ctx, s.db, listing)
+	if err != nil {
+		return err
+	}
+
+	if job.searched != nil {
+		search := models.ListingSearch{
+			AreaID:     job.searched.ID,
+			ListingID:  listing.ID,
+			LastSeenAt: time.Now(),
+		}
+		err = search.Upsert(
+			ctx,
+			s.db,
+			true,
+			[]string{models.ListingSearchColumns.AreaID, models.ListingSearchColumns.ListingID},
+			boil.Whitelist(models.ListingSearchColumns.LastSeenAt),
+			boil.Infer(),
+		)
 		if err != nil {
-			return nil, err
+			return err
 		}
+	}
 
-		err = listing.SetArea(s.db, false, area)
-		if err != nil {
-			return nil, err
+	if previous != nil && previous.Price != 0 && listing.Price != 0 && previous.Price != listing.Price && s.onPriceChange != nil {
+		s.hookMu.Lock()
+		s.onPriceChange(PriceChange{
+			Listing:  listing,
+			OldPrice: previous.Price,
+			NewPrice: listing.Price,
+		})
+		s.hookMu.Unlock()
+	}
+
+	return nil
+}
+
+// volatileCardFields change between runs without the listing itself changing
+var volatileCardFields = []string{"visits", "visitsWeekly"}
+
+// cardsEqual compares two cards ignoring volatileCardFields
+func cardsEqual(a null.JSON, b null.JSON) bool {
+	var ac, bc map[string]interface{}
+	if err := a.Unmarshal(&ac); err != nil {
+		return false
+	}
+	if err := b.Unmarshal(&bc); err != nil {
+		return false
+	}
+
+	for _, field := range volatileCardFields {
+		delete(ac, field)
+		delete(bc, field)
+	}
+
+	return reflect.DeepEqual(ac, bc)
+}
+
+// saveListing upserts the canonical row for the listing's external id, records
+// the observed price and a snapshot when the raw listing data or details have changed.
+// Changes to volatileCardFields alone don't make a snapshot.
+// The previously stored row is returned, or nil if the listing is new
+func saveListing(ctx context.Context, db *sql.DB, listing *models.Listing) (*models.Listing, error) {
+	var previous *models.Listing
+
+	err := transaction.Do(ctx, db, func(tx *sql.Tx) error {
+		var err error
+		previous, err = models.Listings(models.ListingWhere.ExternalID.EQ(listing.ExternalID)).One(ctx, tx)
+		if err != nil && err != sql.ErrNoRows {
+			return err
 		}
 
-		listingDetails, err := getListingDetails(int(apiListing["id"].(float64)), area)
+		// Updating every column but created_at also clears removed_at, reactivating
+		// listings that show up again
+		err = listing.Upsert(
+			ctx,
+			tx,
+			true,
+			[]string{models.ListingColumns.ExternalID},
+			boil.Blacklist(models.ListingColumns.CreatedAt),
+			boil.Infer(),
+		)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		err = listing.ListingDetails.Marshal(listingDetails)
-		if err != nil {
-			return nil, err
+		if listing.Price != 0 {
+			price := models.ListingPriceHistory{
+				ListingID: listing.ID,
+				Price:     listing.Price,
+				SeenOn:    listing.DateAccessed,
+			}
+			err = price.Upsert(
+				ctx,
+				tx,
+				true,
+				[]string{models.ListingPriceHistoryColumns.ListingID, models.ListingPriceHistoryColumns.SeenOn},
+				boil.Whitelist(models.ListingPriceHistoryColumns.Price),
+				boil.Infer(),
+			)
+			if err != nil {
+				return err
+			}
 		}
 
-		err = SetDerivedFields(listing)
-		if err != nil {
-			log.Printf("Failed to set derived fields, err: [%s], listing id: %d", err.Error(), listing.ExternalID)
+		if previous != nil &&
+			cardsEqual(previous.ListingData, listing.ListingData) &&
+			jsonEqual(previous.ListingDetails, listing.ListingDetails) {
+			return nil
 		}
 
-		err = listing.Insert(s.db, boil.Infer())
-		if err != nil {
-			return nil, err
+		snapshot := models.ListingSnapshot{
+			ListingID:      listing.ID,
+			ListingData:    listing.ListingData,
+			ListingDetails: listing.ListingDetails,
 		}
 
-		listings = append(listings, listing)
+		return snapshot.Insert(ctx, tx, boil.Infer())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return previous, nil
+}
+
+// jsonEqual compares two JSON values semantically, ignoring key order and whitespace
+func jsonEqual(a null.JSON, b null.JSON) bool {
+	if !a.Valid || !b.Valid {
+		return a.Valid == b.Valid
+	}
+
+	var av, bv interface{}
+	if err := a.Unmarshal(&av); err != nil {
+		return false
+	}
+	if err := b.Unmarshal(&bv); err != nil {
+		return false
 	}
 
-	return listings, nil
+	return reflect.DeepEqual(av, bv)
 }
 
-func getRequestParams() (requestParams, error) {
+func (s *Scraper) getRequestParams(ctx context.Context) (requestParams, error) {
 	var params requestParams
 
-	resp, err := http.Get("https://asunnot.oikotie.fi/myytavat-asunnot")
+	resp, err := s.fetcher.Get(ctx, s.options.BaseURL+"/"+s.options.Kind.Path())
 	if err != nil {
 		return params, err
 	}
@@ -371,16 +865,27 @@ func parseMetaAttrs(params *requestParams, body io.Reader) error {
 
 type listingDetails = map[string]map[string]string
 
-func getListingDetails(externalID int, area *models.Area) (listingDetails, error) {
-	resp, err := http.Get(fmt.Sprintf("https://asunnot.oikotie.fi/myytavat-asunnot/%s/%d", area.City, externalID))
+// errListingNotFound is returned for a detail page that no longer exists
+var errListingNotFound = errors.New("Listing not found")
+
+// listingURL is the address of a listing's detail page
+func (s *Scraper) listingURL(area *models.Area, listing *models.Listing) string {
+	return fmt.Sprintf("%s/%s/%s/%d", s.options.BaseURL, filter.Kind(listing.Kind).Path(), area.City, listing.ExternalID)
+}
+
+func (s *Scraper) getListingDetails(ctx context.Context, listing *models.Listing, area *models.Area) (listingDetails, error) {
+	resp, err := s.fetcher.Get(ctx, s.listingURL(area, listing))
 	if err != nil {
 		return nil, err
 	}
 
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w, external id: %d", errListingNotFound, listing.ExternalID)
+	}
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Request failed %d %s, external id: %d", resp.StatusCode, resp.Status, externalID)
+		return nil, fmt.Errorf("Request failed %d %s, external id: %d", resp.StatusCode, resp.Status, listing.ExternalID)
 	}
 
 	doc, err := goquery.NewDocumentFromReader(resp.Body)
@@ -412,109 +917,99 @@ func getListingDetails(externalID int, area *models.Area) (listingDetails, error
 	return details, nil
 }
 
-var onlyNumbers = regexp.MustCompile("[^0-9]+")
-var floorReg = regexp.MustCompile("^[0-9]+")
+// ParserVersion is stored with the fields SetDerivedFields derives. Bump it
+// whenever what or how SetDerivedFields derives changes, so that `ot reparse`
+// picks up the listings derived by an older version
+const ParserVersion = 1
 
+// SetDerivedFields derives the listing's columns from its raw card and details.
+// Every field is attempted, the ones that failed are stored in ParseErrors and
+// returned as ParseErrors
 func SetDerivedFields(listing *models.Listing) error {
-	var data map[string]interface{}
-	err := listing.ListingData.Unmarshal(&data)
-	if err != nil {
-		return err
-	}
-	if len(data) == 0 {
-		return fmt.Errorf("data empty")
+	errs := ParseErrors{}
+
+	var card Card
+	cardOK := listing.ListingData.Valid && len(listing.ListingData.JSON) > 0
+	if !cardOK {
+		errs.add("listing_data", errors.New("empty"))
+	} else if err := listing.ListingData.Unmarshal(&card); err != nil {
+		var cardErrs ParseErrors
+		if errors.As(err, &cardErrs) {
+			for field, reason := range cardErrs {
+				errs[field] = reason
+			}
+		} else {
+			errs.add("listing_data", err)
+			cardOK = false
+		}
 	}
 
 	var details listingDetails
-	err = listing.ListingDetails.Unmarshal(&details)
+	err := listing.ListingDetails.Unmarshal(&details)
 	if err != nil {
-		return err
-	}
-	if len(details) == 0 {
-		return fmt.Errorf("details empty")
+		errs.add("listing_details", err)
+	} else if len(details) == 0 {
+		errs.add("listing_details", errors.New("empty"))
 	}
+	detailsOK := len(details) > 0
 
-	externalID, ok := data["id"].(float64)
-	if !ok {
-		return errors.New("Cast failed: ExternalID")
-	}
-	listing.ExternalID = int(externalID)
+	if cardOK {
+		if !errs.has("card.id") {
+			listing.ExternalID = card.ID
+		}
+		if !errs.has("card.price") {
+			listing.Price = int(card.Price)
+		}
+		listing.Visits = card.Visits
 
-	price, err := strconv.Atoi(onlyNumbers.ReplaceAllString(data["price"].(string), ""))
-	if err != nil {
-		return fmt.Errorf("price, %w", err)
+		if !errs.has("card.coordinates") {
+			listing.Coord = geo.NewNullPoint(card.Coordinates.Latitude, card.Coordinates.Longitude)
+		}
 	}
-	listing.Price = price
 
-	size, ok := data["size"].(float64)
-	if !ok {
-		return errors.New("Cast failed: Size")
-	}
-	listing.Size = size
+	if cardOK && detailsOK {
+		if listing.Kind == string(filter.Rent) {
+			setRentFields(listing, details, errs)
+		}
 
-	rooms, ok := data["rooms"].(float64)
-	if !ok {
-		return errors.New("Cast failed: Rooms")
-	}
-	listing.Rooms = int(rooms)
+		listing.PropertyType = string(propertyTypeOf(listing.Kind, card, details))
 
-	visits, ok := data["visits"].(float64)
-	if !ok {
-		return errors.New("Cast failed: Visits")
-	}
-	listing.Visits = int(visits)
+		if !card.Size.Valid && listing.PropertyType != string(Plot) && !errs.has("card.size") {
+			errs.add("card.size", errMissing)
+		}
+		listing.Size = card.Size.Float64
 
-	floor, err := strconv.Atoi(floorReg.FindString(details["Perustiedot"]["Kerros"]))
-	if err != nil {
-		return fmt.Errorf("floor, %w", err)
+		setPropertyFields(listing, card, details, errs)
+		setDetailFields(listing, details, errs)
 	}
-	listing.Floor = floor
 
-	lat, err := getCoordinates(data, "latitude")
-	if err != nil {
-		return err
-	}
-	long, err := getCoordinates(data, "longitude")
-	if err != nil {
-		return err
-	}
-	listing.Coord.Point = pgeo.NewPoint(lat, long)
-	listing.Coord.Valid = true
+	listing.ParseErrors = errs.JSON()
+	listing.ParserVersion = ParserVersion
 
-	return nil
+	return errs.orNil()
 }
 
-func UpdateListing(db *sql.DB, id int) error {
-	listing, err := models.FindListing(db, id)
+// UpdateListing rederives the fields of a listing and saves it, fields that
+// failed to parse are saved as its parse errors and returned
+func UpdateListing(ctx context.Context, db *sql.DB, id int) error {
+	listing, err := models.FindListing(ctx, db, id)
 	if err != nil {
 		return err
 	}
 
-	err = SetDerivedFields(listing)
+	parseErr := SetDerivedFields(listing)
+
+	_, err = listing.Update(ctx, db, boil.Infer())
 	if err != nil {
 		return err
 	}
 
-	_, err = listing.Update(db, boil.Infer())
-	return err
-}
-
-func getCoordinates(data map[string]interface{}, latLong string) (float64, error) {
-	coords, ok := data["coordinates"].(map[string]interface{})
-	if !ok {
-		return 0, fmt.Errorf("Cast failed: Coordinates")
-	}
-	value, ok := coords[latLong].(float64)
-	if !ok {
-		return 0, fmt.Errorf("Cast failed: %s", latLong)
-	}
-
-	return value, nil
+	return parseErr
 }
 
-func downloadImages(area *models.Area, listing *models.Listing) error {
-	url := fmt.Sprintf("https://asunnot.oikotie.fi/myytavat-asunnot/%s/%d/kuvat", area.City, listing.ExternalID)
-	resp, err := http.Get(url)
+func (s *Scraper) downloadImages(ctx context.Context, area *models.Area, listing *models.Listing) error {
+	url := s.listingURL(area, listing) + "/kuvat"
+	resp, err := s.fetcher.Get(ctx, url)
 	if err != nil {
 		return err
 	}
@@ -548,7 +1043,7 @@ func downloadImages(area *models.Area, listing *models.Listing) error {
 		return errors.Wrap(err, "unable to create images folder")
 	}
 	for i, url := range imagesURLs {
-		response, err := http.Get(url)
+		response, err := s.fetcher.Get(ctx, url)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,228 @@
+package scraper
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"oikotie/database/models"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// testDB connects to the database of OT_TEST_DATABASE_URL, skipping the test
+// when it isn't set. The database must be migrated, and is emptied
+func testDB(t *testing.T) *sql.DB {
+	url := os.Getenv("OT_TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("OT_TEST_DATABASE_URL not set")
+	}
+
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec("TRUNCATE areas, listings, area_geometries, geofences RESTART IDENTITY CASCADE")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+// fakeOikotie serves the front page, the cards of one area, and a detail and
+// an empty image page for every card
+type fakeOikotie struct {
+	mu    sync.Mutex
+	cards map[int]string
+}
+
+func (f *fakeOikotie) setCards(cards ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cards = map[int]string{}
+	for _, card := range cards {
+		id, _ := cardID(json.RawMessage(card))
+		f.cards[id] = card
+	}
+}
+
+func (f *fakeOikotie) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.URL.Path == "/myytavat-asunnot":
+		fmt.Fprint(w, `<html><head>
+			<meta name="api-token" content="token">
+			<meta name="loaded" content="1">
+			<meta name="cuid" content="cuid">
+		</head></html>`)
+
+	case r.URL.Path == "/api/cards":
+		if r.Header.Get("ota-token") != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		cards := []json.RawMessage{}
+		if offset == 0 {
+			for _, card := range f.cards {
+				cards = append(cards, json.RawMessage(card))
+			}
+		}
+		_ = json.NewEncoder(w).Encode(cardsResponse{Found: len(f.cards), Cards: cards})
+
+	case len(path) == 3 && path[0] == "myytavat-asunnot":
+		fmt.Fprint(w, `<div class="listing-details-container"><div class="listing-details">
+			<h3>Perustiedot</h3>
+			<dl class="info-table__row"><dt>Kerros</dt><dd>2/5</dd></dl>
+			<dl class="info-table__row"><dt>Rakennusvuosi</dt><dd>1975</dd></dl>
+			<dl class="info-table__row"><dt>Hoitovastike</dt><dd>250 € / kk</dd></dl>
+		</div></div>`)
+
+	case len(path) == 4 && path[3] == "kuvat":
+		fmt.Fprint(w, `<html></html>`)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func testCard(id int, price int) string {
+	return fmt.Sprintf(`{"id": %d, "price": "%d €", "size": 54.5, "rooms": 2, "visits": 10,
+		"coordinates": {"latitude": 60.16, "longitude": 24.94},
+		"buildingData": {"address": "Testikatu 1, 00100 Helsinki", "district": "Kamppi", "city": "Helsinki"}}`, id, price)
+}
+
+// TestRunEndToEnd scrapes a fake Oikotie twice, the second time without one
+// of the listings and with another's price changed
+func TestRunEndToEnd(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+
+	// Images are downloaded under the working directory
+	dir, err := ioutil.TempDir("", "oikotie")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	wd, _ := os.Getwd()
+	defer os.Chdir(wd)
+	err = os.Chdir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	area := &models.Area{ExternalID: 100, Name: "00100", City: "Helsinki", CardType: postcodeCard, Tracked: true}
+	err = area.Insert(ctx, db, boil.Infer())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fake := &fakeOikotie{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	newScraper := func() (*Scraper, *[]PriceChange, *[]*models.Listing) {
+		changes := []PriceChange{}
+		removed := []*models.Listing{}
+
+		fetchOptions := DefaultFetcherOptions()
+		fetchOptions.RetryMax = 0
+		fetchOptions.RequestsPerSecond = 0
+
+		s := Create(db).
+			SetBaseURL(server.URL).
+			SetTrackedAreas(true).
+			SetFull(true).
+			SetFetcherOptions(fetchOptions).
+			OnPriceChange(func(c PriceChange) { changes = append(changes, c) }).
+			OnRemoved(func(l *models.Listing) { removed = append(removed, l) })
+		return s, &changes, &removed
+	}
+
+	fake.setCards(testCard(1001, 200000), testCard(1002, 300000))
+	s, _, _ := newScraper()
+	listings, err := s.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listings) != 2 {
+		t.Fatalf("first run saved %d listings, want 2", len(listings))
+	}
+
+	saved, err := models.Listings(models.ListingWhere.ExternalID.EQ(1001)).One(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Price != 200000 || saved.Size != 54.5 || saved.AreaID != area.ID || !saved.Coord.Valid {
+		t.Errorf("saved listing: price %d, size %v, area %d, coord %v", saved.Price, saved.Size, saved.AreaID, saved.Coord)
+	}
+	if saved.Floor.Int != 2 || saved.ConstructionYear.Int != 1975 {
+		t.Errorf("saved listing: floor %v, construction year %v", saved.Floor, saved.ConstructionYear)
+	}
+
+	fake.setCards(testCard(1001, 190000))
+	s, changes, removed := newScraper()
+	_, err = s.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(*changes) != 1 || (*changes)[0].OldPrice != 200000 || (*changes)[0].NewPrice != 190000 {
+		t.Errorf("price changes = %+v, want 1001 from 200000 to 190000", *changes)
+	}
+	if len(*removed) != 1 || (*removed)[0].ExternalID != 1002 {
+		t.Errorf("removed = %+v, want 1002", *removed)
+	}
+
+	history, err := models.ListingPriceHistories().Count(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Both runs are on the same day, the second price replaces the first
+	if history != 2 {
+		t.Errorf("price history has %d rows, want 2", history)
+	}
+}
+
+func TestCardsEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{`{"id": 1, "price": 100}`, `{"price": 100, "id": 1}`, true},
+		// Only the visits changed
+		{`{"id": 1, "price": 100, "visits": 10, "visitsWeekly": 2}`, `{"id": 1, "price": 100, "visits": 12, "visitsWeekly": 4}`, true},
+		{`{"id": 1, "price": 100, "visits": 10}`, `{"id": 1, "price": 100}`, true},
+		{`{"id": 1, "price": 100, "visits": 10}`, `{"id": 1, "price": 90, "visits": 10}`, false},
+		{`{"id": 1, "size": 54}`, `{"id": 1}`, false},
+		{`{"id": 1}`, ``, false},
+		{`{"id": 1}`, `not json`, false},
+	}
+
+	for _, tt := range tests {
+		b := null.JSONFrom([]byte(tt.b))
+		if tt.b == "" {
+			b = null.JSON{}
+		}
+		got := cardsEqual(null.JSONFrom([]byte(tt.a)), b)
+		if got != tt.want {
+			t.Errorf("cardsEqual(%s, %s) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package scraper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// maxSessionRefreshes caps how many times a single run re-authenticates
+const maxSessionRefreshes = 5
+
+// session holds the request params scraped from the Oikotie front page. The
+// generation is bumped on every refresh so that concurrent workers hitting the
+// same expired session only refresh it once
+type session struct {
+	params     requestParams
+	generation int
+	refreshes  int
+}
+
+func (s *Scraper) startSession(ctx context.Context) error {
+	params, err := s.getRequestParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.sessionMu.Lock()
+	defer s.sessionMu.Unlock()
+	s.session = session{params: params}
+
+	return nil
+}
+
+func (s *Scraper) currentSession() (requestParams, int) {
+	s.sessionMu.RLock()
+	defer s.sessionMu.RUnlock()
+	return s.session.params, s.session.generation
+}
+
+// refreshSession fetches new request params unless the session has already been
+// refreshed since generation. Refreshes are serialized by refreshMu, requests
+// keep using the current session while the front page is fetched
+func (s *Scraper) refreshSession(ctx context.Context, generation int) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	s.sessionMu.RLock()
+	current, refreshes := s.session.generation, s.session.refreshes
+	s.sessionMu.RUnlock()
+
+	if generation != current {
+		return nil
+	}
+
+	if refreshes >= maxSessionRefreshes {
+		return fmt.Errorf("Session expired, refresh limit of %d reached", maxSessionRefreshes)
+	}
+
+	params, err := s.getRequestParams(ctx)
+	if err != nil {
+		return fmt.Errorf("Session refresh failed, %w", err)
+	}
+
+	s.sessionMu.Lock()
+	s.session.params = params
+	s.session.generation++
+	s.session.refreshes++
+	refreshes = s.session.refreshes
+	s.sessionMu.Unlock()
+
+	log.Printf("Refreshed Oikotie session (%d/%d)", refreshes, maxSessionRefreshes)
+
+	return nil
+}
+
+// doAPI makes an authenticated API request, refreshing the session and retrying
+// when the response indicates that the session has expired. The request's
+// context is used for every attempt
+func (s *Scraper) doAPI(req *http.Request) (*http.Response, error) {
+	for {
+		params, generation := s.currentSession()
+
+		r := req.Clone(req.Context())
+		r.Header.Set("ota-token", params.token)
+		r.Header.Set("ota-cuid", params.cuid)
+		r.Header.Set("ota-loaded", params.loaded)
+		for _, cookie := range params.cookies {
+			r.AddCookie(cookie)
+		}
+
+		resp, err := s.fetcher.Do(r)
+		if err != nil {
+			return nil, err
+		}
+
+		expired, err := isSessionExpired(resp)
+		if err != nil {
+			return nil, err
+		}
+		if !expired {
+			return resp, nil
+		}
+
+		err = s.refreshSession(req.Context(), generation)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isSessionExpired checks for auth failures, either by status code or by a JSON
+// error body mentioning the token. Other error pages, e.g. HTML ones carrying
+// the api-token meta tag, are left alone. The body is left readable unless it
+// expired
+func isSessionExpired(resp *http.Response) (bool, error) {
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		resp.Body.Close()
+		return true, nil
+	}
+
+	if resp.StatusCode < 400 || !isJSON(resp) {
+		return false, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return false, err
+	}
+
+	lower := strings.ToLower(string(body))
+	if strings.Contains(lower, "token") || strings.Contains(lower, "cuid") {
+		return true, nil
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return false, nil
+}
+
+func isJSON(resp *http.Response) bool {
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,228 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeSession serves a front page handing out a new token on every load, and
+// an API endpoint answering with api for each request
+type fakeSession struct {
+	mu    sync.Mutex
+	loads int
+	calls int
+	api   func(w http.ResponseWriter, token string)
+}
+
+func (f *fakeSession) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/myytavat-asunnot":
+		f.loads++
+		fmt.Fprintf(w, `<html><head>
+			<meta name="api-token" content="token-%d">
+			<meta name="loaded" content="1">
+			<meta name="cuid" content="cuid">
+		</head></html>`, f.loads)
+
+	case "/api/3.0/test":
+		f.calls++
+		f.api(w, r.Header.Get("ota-token"))
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newSessionScraper(t *testing.T, f *fakeSession) *Scraper {
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+
+	o := DefaultFetcherOptions()
+	o.RetryMax = 0
+	o.RequestsPerSecond = 0
+
+	s := Create(nil).SetBaseURL(server.URL)
+	s.fetcher = newFetcher(o)
+
+	err := s.startSession(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func TestDoAPI(t *testing.T) {
+	tests := []struct {
+		name string
+		api  func(w http.ResponseWriter, token string)
+		// status and body are those of the response doAPI returns
+		status int
+		body   string
+		loads  int
+	}{
+		{
+			name: "valid session",
+			api: func(w http.ResponseWriter, token string) {
+				fmt.Fprint(w, "ok")
+			},
+			status: http.StatusOK,
+			body:   "ok",
+			loads:  1,
+		},
+		{
+			name: "unauthorized",
+			api: func(w http.ResponseWriter, token string) {
+				if token != "token-2" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				fmt.Fprint(w, "ok")
+			},
+			status: http.StatusOK,
+			body:   "ok",
+			loads:  2,
+		},
+		{
+			name: "forbidden",
+			api: func(w http.ResponseWriter, token string) {
+				if token != "token-2" {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				fmt.Fprint(w, "ok")
+			},
+			status: http.StatusOK,
+			body:   "ok",
+			loads:  2,
+		},
+		{
+			name: "JSON error about the token",
+			api: func(w http.ResponseWriter, token string) {
+				if token != "token-2" {
+					w.Header().Set("Content-Type", "application/json; charset=utf-8")
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(w, `{"error": "Invalid OTA token"}`)
+					return
+				}
+				fmt.Fprint(w, "ok")
+			},
+			status: http.StatusOK,
+			body:   "ok",
+			loads:  2,
+		},
+		{
+			name: "JSON error about something else",
+			api: func(w http.ResponseWriter, token string) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error": "Invalid offset"}`)
+			},
+			status: http.StatusBadRequest,
+			body:   `{"error": "Invalid offset"}`,
+			loads:  1,
+		},
+		{
+			// The error page is a regular page with the session's meta tags
+			name: "HTML not found page",
+			api: func(w http.ResponseWriter, token string) {
+				w.Header().Set("Content-Type", "text/html")
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `<meta name="api-token" content="token">`)
+			},
+			status: http.StatusNotFound,
+			body:   `<meta name="api-token" content="token">`,
+			loads:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		f := &fakeSession{api: tt.api}
+		s := newSessionScraper(t, f)
+
+		resp, err := s.doAPI(s.apiCall(context.Background(), "test"))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+
+		if resp.StatusCode != tt.status || string(body) != tt.body {
+			t.Errorf("%s: got %d %q, want %d %q", tt.name, resp.StatusCode, body, tt.status, tt.body)
+		}
+		if f.loads != tt.loads {
+			t.Errorf("%s: front page loaded %d times, want %d", tt.name, f.loads, tt.loads)
+		}
+	}
+}
+
+func TestDoAPIRefreshLimit(t *testing.T) {
+	f := &fakeSession{api: func(w http.ResponseWriter, token string) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}}
+	s := newSessionScraper(t, f)
+
+	_, err := s.doAPI(s.apiCall(context.Background(), "test"))
+	if err == nil || !strings.Contains(err.Error(), "refresh limit") {
+		t.Fatalf("error = %v, want the refresh limit", err)
+	}
+	if f.loads != 1+maxSessionRefreshes || f.calls != 1+maxSessionRefreshes {
+		t.Errorf("front page loaded %d times and API called %d times, want %d", f.loads, f.calls, 1+maxSessionRefreshes)
+	}
+}
+
+// TestDoAPIConcurrentRefresh expires the session of several requests at once,
+// which refresh it only once
+func TestDoAPIConcurrentRefresh(t *testing.T) {
+	const requests = 8
+
+	f := &fakeSession{api: func(w http.ResponseWriter, token string) {
+		if token == "token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, "ok")
+	}}
+	s := newSessionScraper(t, f)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, requests)
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := s.doAPI(s.apiCall(context.Background(), "test"))
+			if err != nil {
+				errs <- err
+				return
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				errs <- fmt.Errorf("status %d", resp.StatusCode)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+	if f.loads != 2 {
+		t.Errorf("front page loaded %d times, want 2", f.loads)
+	}
+}
@@ -2,6 +2,7 @@ package tg
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,8 +12,8 @@ import (
 	"github.com/pkg/errors"
 )
 
-func SendMessage(cfg *config.Reader, msg string) error {
-	return send(cfg.TgBotToken(), cfg.TgChatID(), msg)
+func SendMessage(ctx context.Context, cfg *config.Reader, msg string) error {
+	return send(ctx, cfg.TgBotToken(), cfg.TgChatID(), msg)
 }
 
 type sendRequest struct {
@@ -20,7 +21,7 @@ type sendRequest struct {
 	Text   string `json:"text"`
 }
 
-func send(token string, chatId string, msg string) error {
+func send(ctx context.Context, token string, chatId string, msg string) error {
 	url := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", token)
 	req := sendRequest{
 		ChatID: chatId,
@@ -32,7 +33,13 @@ func send(token string, chatId string, msg string) error {
 		return errors.WithStack(err)
 	}
 
-	resp, err := http.Post(url, "application/json", bytes.NewBuffer(r))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(r))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(httpReq)
 	if err != nil {
 		return errors.WithStack(err)
 	}